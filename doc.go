@@ -44,6 +44,10 @@ The flags are:
 		display offending line with this many lines of context (default -1)
 	-zerotrace
 		trace found zero-sized types
+	-platforms list
+		analyze for each GOOS/GOARCH in this comma-separated list and merge the findings
+	-fix-verify
+		apply suggested fixes that still type-check, report the others as unfixable
 
 # Examples
 
@@ -54,5 +58,13 @@ To check the current package for basic problems with pointers to zero-sized type
 To fix all issues across packages, using an exclude file:
 
 	zerolint -level=full -excluded=excludes.txt -fix ./...
+
+To apply only the fixes that don't break the build:
+
+	zerolint -level=full -fix-verify ./...
+
+To check for types that are zero-sized only on some platforms:
+
+	zerolint -platforms=linux/amd64,windows/arm64,js/wasm ./...
 */
 package main
@@ -0,0 +1,104 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+
+	"fillmore-labs.com/zerolint/pkg/zerolint/fixverify"
+)
+
+const fixVerifyFlag = "fix-verify"
+
+// runFixVerify applies the suggested fixes for the packages given on the command line that still type-check
+// and prints the remaining findings, labeled with the reason when a fix was dropped.
+func runFixVerify(a *analysis.Analyzer, args []string, stdout io.Writer) int {
+	fs := flag.NewFlagSet(a.Name, flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: %s -%s [flags] [package ...]\n", a.Name, fixVerifyFlag)
+		fs.PrintDefaults()
+	}
+
+	a.Flags.VisitAll(func(f *flag.Flag) { fs.Var(f.Value, f.Name, f.Usage) })
+
+	addDriverFlags(fs)
+
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+
+		return exitError
+	}
+
+	if names := driverFlagsGiven(fs); len(names) > 0 {
+		fmt.Fprintf(fs.Output(), "%s can't be combined with -%s\n", strings.Join(names, ", "), fixVerifyFlag)
+
+		return exitError
+	}
+
+	res, err := fixverify.Verify(a, "", fs.Args()...)
+	if err != nil {
+		log.Print(err)
+
+		return exitError
+	}
+
+	for _, file := range slices.Sorted(maps.Keys(res.Files)) {
+		if err := writeFile(file, res.Files[file]); err != nil {
+			log.Print(err)
+
+			return exitError
+		}
+	}
+
+	for _, f := range res.Findings {
+		if f.Unfixable == nil {
+			fmt.Fprintf(stdout, "%s: %s\n", f.Posn, f.Message)
+
+			continue
+		}
+
+		fmt.Fprintf(stdout, "%s: %s [unfixable: %v]\n", f.Posn, f.Message, f.Unfixable)
+	}
+
+	if len(res.Findings) > 0 {
+		return exitDiagnostics
+	}
+
+	return exitOK
+}
+
+// writeFile replaces the content of an existing file, keeping its permissions.
+func writeFile(name string, content []byte) error {
+	fi, err := os.Stat(name)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(name, content, fi.Mode().Perm())
+}
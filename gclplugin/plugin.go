@@ -36,6 +36,10 @@ type Settings struct {
 	Level     *level.LintLevel `json:"level,omitempty"`
 	Match     *regexp.Regexp   `json:"match,omitempty"`
 	Generated *bool            `json:"generated,omitempty"`
+
+	// Alternatives offers alternative suggested fixes. Disabled by default,
+	// since golangci-lint might apply all of them with --fix.
+	Alternatives *bool `json:"alternatives,omitempty"`
 }
 
 // New creates a new [Plugin] instance with the given [Settings].
@@ -73,6 +77,9 @@ func (p Plugin) BuildAnalyzers() ([]*analysis.Analyzer, error) {
 		opts = append(opts, zerolint.WithGenerated(*p.settings.Generated))
 	}
 
+	alternatives := p.settings.Alternatives != nil && *p.settings.Alternatives
+	opts = append(opts, zerolint.WithAlternatives(alternatives))
+
 	z := zerolint.New(opts...)
 
 	return []*analysis.Analyzer{z}, nil
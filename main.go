@@ -17,6 +17,8 @@
 package main
 
 import (
+	"os"
+
 	"golang.org/x/tools/go/analysis/singlechecker"
 
 	"fillmore-labs.com/zerolint/pkg/zerolint"
@@ -28,5 +30,16 @@ func main() {
 		a.Flags.BoolFunc("V", "print version and exit", version)
 	}
 
+	// Registered for the driver, too, so that -fix-verify=false is accepted.
+	a.Flags.Bool(fixVerifyFlag, false, "apply suggested fixes that still type-check")
+
+	switch args := os.Args[1:]; {
+	case hasFlag(a, args, platformsFlag):
+		os.Exit(runPlatforms(a, args, os.Stdout))
+
+	case boolFlag(a, args, fixVerifyFlag):
+		os.Exit(runFixVerify(a, args, os.Stdout))
+	}
+
 	singlechecker.Main(a)
 }
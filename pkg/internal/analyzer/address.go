@@ -0,0 +1,82 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analyzer
+
+import (
+	"go/ast"
+
+	"fillmore-labs.com/zerolint/pkg/internal/analyzer/msg"
+	"fillmore-labs.com/zerolint/pkg/internal/typeutil"
+)
+
+// reflectValueOf is the function name of [reflect.ValueOf].
+var reflectValueOf = typeutil.FuncName{Path: "reflect", Name: "ValueOf"} //nolint:gochecknoglobals
+
+// visitReflectAddress analyzes reflect.ValueOf(x).Pointer() and reflect.ValueOf(x).UnsafePointer(),
+// where x is a pointer to a zero-sized type.
+func (v *Visitor) visitReflectAddress(n *ast.CallExpr, methodExpr bool, funcName typeutil.FuncName) bool {
+	var recv ast.Expr
+
+	switch {
+	case methodExpr: // reflect.Value.Pointer(reflect.ValueOf(x))
+		if len(n.Args) != 1 {
+			return true
+		}
+
+		recv = n.Args[0]
+
+	default: // reflect.ValueOf(x).Pointer()
+		s, ok := ast.Unparen(n.Fun).(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+
+		recv = s.X
+	}
+
+	c, ok := ast.Unparen(recv).(*ast.CallExpr)
+	if !ok || len(c.Args) != 1 {
+		return true
+	}
+
+	if fun, _, ok := typeutil.FuncOf(v.Diag.TypesInfo(), c.Fun); !ok || typeutil.NewFuncName(fun) != reflectValueOf {
+		return true
+	}
+
+	v.checkAddressArg(n, c.Args[0], funcName)
+
+	return true
+}
+
+// checkAddressArg reports extracting the address of x, when x is a pointer to a zero-sized type.
+func (v *Visitor) checkAddressArg(n ast.Node, x ast.Expr, funcName typeutil.FuncName) {
+	tv, ok := v.Diag.TypesInfo().Types[x]
+	if !ok { // should not happen
+		v.Diag.LogErrorf(x, "Can't find address argument type")
+
+		return
+	}
+
+	elem, valueMethod, zeroSized := v.Check.ZeroSizedTypePointer(tv.Type)
+	if !zeroSized {
+		return
+	}
+
+	cM := msg.Formatf(msg.CatAddressValue, valueMethod,
+		"address of pointer to zero-size type %q extracted by %s", elem, funcName)
+	v.Diag.Report(n, cM, nil)
+}
@@ -0,0 +1,85 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analyzer
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// typeAlternatives returns alternative fixes for diagnostics about a zero-sized type declared in the current package:
+// Making the type non-zero-sized, for error types with an `Is` method, or excluding the type from analysis.
+// Types with unkeyed composite literals are not changed, since the literals would no longer compile.
+func (v *Visitor) typeAlternatives(t types.Type) []analysis.SuggestedFix {
+	if !v.Alternatives {
+		return nil
+	}
+
+	decl, spec, ok := v.typeDecl(t)
+	if !ok {
+		return nil
+	}
+
+	var fixes []analysis.SuggestedFix
+	if !v.hasUnkeyedLiterals(t) {
+		withIs := false
+		if ptr := types.NewPointer(t); types.Implements(ptr, errorType) {
+			obj, _, _ := types.LookupFieldOrMethod(ptr, false, v.Diag.Pkg(), "Is")
+			withIs = obj == nil
+		}
+
+		fixes = v.Diag.AddField(decl, spec, withIs)
+	}
+
+	return append(fixes, v.Diag.ExcludeType(decl)...)
+}
+
+// typeDecl finds the declaration of the named type t in the current package.
+func (v *Visitor) typeDecl(t types.Type) (*ast.GenDecl, *ast.TypeSpec, bool) {
+	named, ok := t.(*types.Named)
+	if !ok {
+		return nil, nil, false
+	}
+
+	obj := named.Obj()
+	if obj.Pkg() != v.Diag.Pkg() {
+		return nil, nil, false
+	}
+
+	f := v.Diag.File(obj.Pos())
+	if f == nil || !v.Generated && ast.IsGenerated(f) {
+		return nil, nil, false
+	}
+
+	for _, d := range f.Decls {
+		decl, ok := d.(*ast.GenDecl)
+		if !ok || decl.Tok != token.TYPE || decl.Pos() > obj.Pos() || obj.Pos() >= decl.End() {
+			continue
+		}
+
+		for _, s := range decl.Specs {
+			if spec, ok := s.(*ast.TypeSpec); ok && spec.Name.Pos() == obj.Pos() {
+				return decl, spec, true
+			}
+		}
+	}
+
+	return nil, nil, false
+}
@@ -25,11 +25,13 @@ import (
 
 	"golang.org/x/tools/go/analysis"
 	"golang.org/x/tools/go/analysis/analysistest"
+	"golang.org/x/tools/go/analysis/passes/buildssa"
 	"golang.org/x/tools/go/analysis/passes/inspect"
 
 	. "fillmore-labs.com/zerolint/pkg/internal/analyzer"
 	"fillmore-labs.com/zerolint/pkg/internal/checker"
 	"fillmore-labs.com/zerolint/pkg/internal/excludes"
+	"fillmore-labs.com/zerolint/pkg/internal/passes/construction"
 	"fillmore-labs.com/zerolint/pkg/internal/passes/exclusions"
 	"fillmore-labs.com/zerolint/pkg/internal/set"
 	"fillmore-labs.com/zerolint/pkg/zerolint/level"
@@ -47,11 +49,12 @@ func TestAnalyzer(t *testing.T) { //nolint:funlen
 	}
 
 	type args struct {
-		level     level.LintLevel
-		excludes  set.Set[string]
-		generated bool
-		regex     *regexp.Regexp
-		pkg       string
+		level        level.LintLevel
+		excludes     set.Set[string]
+		generated    bool
+		alternatives bool
+		regex        *regexp.Regexp
+		pkg          string
 	}
 
 	testre := regexp.MustCompile("^test/.*$")
@@ -64,6 +67,7 @@ func TestAnalyzer(t *testing.T) { //nolint:funlen
 		{"basic", args{level: level.Basic, regex: testre, pkg: "test/basic"}, "test/basic.myError (value methods)"},
 		{"full", args{level: level.Full, excludes: set.New(excludedTypeNames...), pkg: "test/a"}, "[0]string"},
 		{"exclusions", args{level: level.Full, pkg: "test/e"}, "test/e.NotExcluded"},
+		{"alternatives", args{level: level.Extended, alternatives: true, pkg: "test/alt"}, "test/alt.closedError"},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -73,8 +77,9 @@ func TestAnalyzer(t *testing.T) { //nolint:funlen
 				Check: checker.Checker{
 					Excludes: tt.args.excludes,
 				},
-				Level:     tt.args.level,
-				Generated: tt.args.generated,
+				Level:        tt.args.level,
+				Generated:    tt.args.generated,
+				Alternatives: tt.args.alternatives,
 			}
 			if tt.args.regex != nil && tt.args.regex.String() != "" {
 				v.Check.Regex = tt.args.regex
@@ -83,7 +88,7 @@ func TestAnalyzer(t *testing.T) { //nolint:funlen
 				Name:       "zerolint",
 				Doc:        "...",
 				Run:        v.Run,
-				Requires:   []*analysis.Analyzer{inspect.Analyzer, exclusions.Analyzer},
+				Requires:   []*analysis.Analyzer{inspect.Analyzer, buildssa.Analyzer, exclusions.Analyzer, construction.Analyzer},
 				ResultType: reflect.TypeFor[result.Detected](),
 			}
 
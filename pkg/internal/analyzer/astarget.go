@@ -0,0 +1,136 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analyzer
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ast/astutil"
+	"golang.org/x/tools/go/ast/edge"
+
+	"fillmore-labs.com/zerolint/pkg/internal/analyzer/msg"
+	"fillmore-labs.com/zerolint/pkg/internal/diag"
+	"fillmore-labs.com/zerolint/pkg/internal/typeutil"
+)
+
+// visitAsTarget analyzes the target of errors.As and similar functions.
+// A target of type **T for a zero-sized type T only matches errors constructed as pointers.
+func (v *Visitor) visitAsTarget(x ast.Expr, funcName typeutil.FuncName) bool {
+	tv, ok := v.Diag.TypesInfo().Types[x]
+	if !ok { // should not happen
+		v.Diag.LogErrorf(x, "Can't find target type")
+
+		return false
+	}
+
+	p, ok := tv.Type.Underlying().(*types.Pointer)
+	if !ok {
+		return false
+	}
+
+	elem, valueMethod, zeroSized := v.Check.ZeroSizedTypePointer(p.Elem())
+	if !zeroSized {
+		return false
+	}
+
+	cM := msg.Formatf(msg.CatAsTarget, valueMethod,
+		"target of %s is pointer to pointer to zero-size type %q", funcName, elem)
+
+	var fixes []analysis.SuggestedFix
+	if valueMethod {
+		fixes = v.valueTarget(x, elem)
+	}
+
+	v.Diag.Report(x, cM, fixes)
+
+	return false // Do not report pointers in errors.As(..., ...).
+}
+
+// valueTarget suggests a fix that changes the declaration of the target variable in `&target` from `var target *T`
+// to `var target T`, if possible. Nil checks and nil assignments of the target are rewritten, other uses
+// that need the pointer prevent the fix.
+func (v *Visitor) valueTarget(x ast.Expr, elem types.Type) []analysis.SuggestedFix {
+	u, ok := ast.Unparen(x).(*ast.UnaryExpr)
+	if !ok || u.Op != token.AND {
+		return nil
+	}
+
+	id, ok := ast.Unparen(u.X).(*ast.Ident)
+	if !ok {
+		return nil
+	}
+
+	obj, ok := v.Diag.TypesInfo().Uses[id].(*types.Var)
+	if !ok {
+		return nil
+	}
+
+	f := v.Diag.CurrentFile
+	if f == nil || obj.Pos() < f.FileStart || obj.Pos() >= f.FileEnd { // Declared in another file.
+		return nil
+	}
+
+	path, _ := astutil.PathEnclosingInterval(f, obj.Pos(), obj.Pos())
+	for _, n := range path {
+		spec, ok := n.(*ast.ValueSpec)
+		if !ok {
+			continue
+		}
+
+		if len(spec.Names) != 1 || len(spec.Values) > 0 || spec.Type == nil {
+			return nil // var target, other *T; var target *T = ...
+		}
+
+		if v.needsPointer(obj, id, elem) {
+			return nil
+		}
+
+		return v.removeStarOf(spec.Type, spec.Names, nil, elem)
+	}
+
+	return nil
+}
+
+// needsPointer reports whether the target variable obj has uses besides the target id, selectors and
+// nil checks or nil assignments, which might need the pointer.
+func (v *Visitor) needsPointer(obj types.Object, id *ast.Ident, elem types.Type) bool {
+	imports := make(diag.Imports)
+
+	for _, use := range v.usesOf(obj) {
+		if use == id {
+			continue
+		}
+
+		c, ok := v.root.FindByPos(use.Pos(), use.End())
+		if !ok || c.Node() != use {
+			return true
+		}
+
+		if k, _ := unparen(c).ParentEdge(); k == edge.SelectorExpr_X {
+			continue // target.f or target.m(), which work with values, too.
+		}
+
+		if edits, ok := v.nilUseEdits(use, elem, imports); !ok || len(edits) == 0 {
+			return true
+		}
+	}
+
+	return false
+}
@@ -18,26 +18,28 @@ package analyzer
 
 import (
 	"go/ast"
+	"go/token"
 	"go/types"
 
+	"golang.org/x/tools/go/analysis"
+
 	"fillmore-labs.com/zerolint/pkg/internal/analyzer/msg"
 	"fillmore-labs.com/zerolint/pkg/internal/diag"
 )
 
 // visitCmp analyzes comparison expressions (x == y, x != y, errors.Is(x, y)) for comparisons
-// involving pointers to zero-sized types.
+// involving pointers to zero-sized types or values of the same zero-sized type.
 func (v *Visitor) visitCmp(n ast.Node, x, y ast.Expr) bool {
-	var (
-		left, right operandInfo
-		ok          bool
-	)
-
-	if left, ok = v.operandInfo(x); !ok {
+	if v.visitCmpValues(n, x, y) {
 		return true
 	}
 
-	if right, ok = v.operandInfo(y); !ok {
-		return true
+	left, leftOk := v.operandInfo(x)
+	right, rightOk := v.operandInfo(y)
+
+	if !leftOk || !rightOk {
+		// Delegate to visitCmpComposite for comparisons of structs and arrays.
+		return v.visitCmpComposite(n, x, y)
 	}
 
 	var cM diag.CategorizedMessage
@@ -63,6 +65,87 @@ func (v *Visitor) visitCmp(n ast.Node, x, y ast.Expr) bool {
 	return true
 }
 
+// visitCmpValues reports comparisons of two values of the same zero-sized type, which are always equal.
+// It returns true when the comparison has been reported.
+func (v *Visitor) visitCmpValues(n ast.Node, x, y ast.Expr) bool {
+	tx, ty := v.Diag.TypesInfo().TypeOf(x), v.Diag.TypesInfo().TypeOf(y)
+	if tx == nil || ty == nil || !types.Identical(tx, ty) || types.IsInterface(tx) {
+		return false
+	}
+
+	if _, ok := tx.Underlying().(*types.Pointer); ok {
+		return false
+	}
+
+	valueMethod, zeroSized := v.Check.ZeroSizedType(tx)
+	if !zeroSized {
+		return false
+	}
+
+	b, ok := n.(*ast.BinaryExpr)
+	if !ok { // errors.Is(x, y) or switch case
+		cM := msg.Formatf(msg.CatComparisonValue, valueMethod,
+			"values of zero-sized type %q always compare equal", tx)
+		v.Diag.Report(n, cM, nil)
+
+		return true
+	}
+
+	result := b.Op == token.EQL
+	cM := msg.Formatf(msg.CatComparisonValue, valueMethod,
+		"comparison of values of zero-sized type %q is always %t", tx, result)
+
+	var fixes []analysis.SuggestedFix
+	if v.isPure(x) && v.isPure(y) {
+		fixes = v.Diag.ReplaceWithConstant(b, result)
+		if v.removesLastUse(fixes[0].TextEdits) { // Unused variables don't compile.
+			fixes = nil
+		}
+	}
+
+	v.Diag.Report(n, cM, fixes)
+
+	return true
+}
+
+// isPure checks whether evaluating x has no side effects, so it can be removed.
+func (v *Visitor) isPure(x ast.Expr) bool {
+	switch x := x.(type) {
+	case *ast.Ident, *ast.BasicLit, *ast.FuncLit:
+		return true
+
+	case *ast.ParenExpr:
+		return v.isPure(x.X)
+
+	case *ast.SelectorExpr:
+		sel, ok := v.Diag.TypesInfo().Selections[x]
+		if !ok { // Qualified identifier
+			return true
+		}
+
+		return sel.Kind() == types.FieldVal && !sel.Indirect() && v.isPure(x.X)
+
+	case *ast.CompositeLit:
+		for _, elt := range x.Elts {
+			if kv, ok := elt.(*ast.KeyValueExpr); ok {
+				elt = kv.Value
+			}
+
+			if !v.isPure(elt) {
+				return false
+			}
+		}
+
+		return true
+
+	case *ast.CallExpr: // Conversion T(x)
+		return len(x.Args) == 1 && v.Diag.TypesInfo().Types[x.Fun].IsType() && v.isPure(x.Args[0])
+
+	default:
+		return false
+	}
+}
+
 // operandInfo holds type information for comparison operands.
 type operandInfo struct {
 	zeroSizedPointer, valueMethod bool
@@ -93,3 +176,70 @@ func (v *Visitor) operandInfo(x ast.Expr) (operandInfo, bool) {
 
 	return operandInfo{}, false // other comparisons
 }
+
+// visitCmpComposite analyzes comparisons of comparable structs and arrays that transitively
+// contain pointers to zero-sized types, which are compared as part of the composite value.
+func (v *Visitor) visitCmpComposite(n ast.Node, x, y ast.Expr) bool {
+	for _, op := range [...]ast.Expr{x, y} {
+		t := v.Diag.TypesInfo().TypeOf(op)
+		if t == nil {
+			continue
+		}
+
+		c, ok := v.componentInfo(t, types.ExprString(ast.Unparen(op)))
+		if !ok {
+			continue
+		}
+
+		cM := msg.ComparisonMessageComposite(c.path, c.elem, c.valueMethod)
+		v.Diag.Report(n, cM, nil)
+
+		break
+	}
+
+	// no fixes, so dive deeper.
+	return true
+}
+
+// componentInfo holds information about a component of a composite type that is a pointer to a zero-sized type.
+type componentInfo struct {
+	path        string
+	elem        types.Type
+	valueMethod bool
+}
+
+// componentInfo walks struct fields and array elements of t and returns the path of the first component
+// that is a pointer to a zero-sized type. Blank fields and zero-length arrays are skipped,
+// since they do not take part in comparisons.
+func (v *Visitor) componentInfo(t types.Type, path string) (componentInfo, bool) {
+	switch u := t.Underlying().(type) {
+	case *types.Struct:
+		for f := range u.Fields() {
+			if f.Name() == "_" {
+				continue
+			}
+
+			if c, ok := v.componentInfoOf(f.Type(), path+"."+f.Name()); ok {
+				return c, true
+			}
+		}
+
+	case *types.Array:
+		if u.Len() == 0 {
+			break
+		}
+
+		return v.componentInfoOf(u.Elem(), path+"[i]")
+	}
+
+	return componentInfo{}, false
+}
+
+// componentInfoOf checks whether t is a pointer to a zero-sized type, otherwise it descends into t.
+func (v *Visitor) componentInfoOf(t types.Type, path string) (componentInfo, bool) {
+	if elem, valueMethod, zeroSized := v.Check.ZeroSizedTypePointer(t); zeroSized {
+		return componentInfo{path: path, elem: elem, valueMethod: valueMethod}, true
+	}
+
+	return v.componentInfo(t, path)
+}
@@ -0,0 +1,45 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analyzer
+
+import (
+	"fillmore-labs.com/zerolint/pkg/internal/analyzer/msg"
+	"fillmore-labs.com/zerolint/pkg/internal/passes/construction"
+)
+
+// checkConstructions reports constructions of zero-sized error types that deviate from the dominant form,
+// since `errors.As` with a target of either value or pointer type only matches one of them.
+func (v *Visitor) checkConstructions(res construction.Result) {
+	for _, site := range res.Sites {
+		if site.Generated && !v.Generated {
+			continue
+		}
+
+		pointer, mixed := res.Counts[site.TypeName].Dominant()
+		if !mixed || site.Pointer == pointer {
+			continue
+		}
+
+		valueMethod, zeroSized := v.Check.ZeroSizedType(site.Type)
+		if !zeroSized {
+			continue
+		}
+
+		cM := msg.ConstructionMessage(site.Type, valueMethod, site.Pointer)
+		v.Diag.Report(site.Expr, cM, nil)
+	}
+}
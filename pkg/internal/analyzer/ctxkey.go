@@ -0,0 +1,55 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analyzer
+
+import (
+	"go/ast"
+	"go/token"
+
+	"golang.org/x/tools/go/analysis"
+
+	"fillmore-labs.com/zerolint/pkg/internal/analyzer/msg"
+)
+
+// visitContextKey analyzes keys passed to context.WithValue and Context.Value.
+//
+// Context values are looked up by comparing keys, so a key that is a pointer to a zero-sized type
+// relies on distinct zero-size allocations comparing equal, which is unspecified.
+func (v *Visitor) visitContextKey(x ast.Expr) bool {
+	tv, ok := v.Diag.TypesInfo().Types[x]
+	if !ok { // should not happen
+		v.Diag.LogErrorf(x, "Can't find context key type")
+
+		return true
+	}
+
+	elem, valueMethod, zeroSized := v.Check.ZeroSizedTypePointer(tv.Type)
+	if !zeroSized {
+		return true
+	}
+
+	cM := msg.Formatf(msg.CatContextKey, valueMethod, "context key is pointer to zero-size type %q", elem)
+
+	var fixes []analysis.SuggestedFix
+	if u, ok := ast.Unparen(x).(*ast.UnaryExpr); ok && u.Op == token.AND {
+		fixes = v.Diag.RemoveOp(u, u.X) // &key{} -> key{}
+	}
+
+	v.Diag.Report(x, cM, fixes)
+
+	return true
+}
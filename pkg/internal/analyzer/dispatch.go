@@ -36,11 +36,17 @@ func (v *Visitor) dispatch(c inspector.Cursor) (proceed bool) {
 		return v.visitCall(n)
 	case *ast.File:
 		return v.visitFile(n)
+	case *ast.SwitchStmt:
+		return v.visitSwitch(n)
 	// keep-sorted end
 
 	// keep-sorted start
 	case *ast.FuncDecl:
 		return v.visitFuncDecl(c, n)
+	case *ast.IndexExpr:
+		return v.visitIndex(n)
+	case *ast.MapType:
+		return v.visitMapType(n)
 	case *ast.StarExpr:
 		return v.visitStar(n)
 	case *ast.StructType:
@@ -50,6 +56,8 @@ func (v *Visitor) dispatch(c inspector.Cursor) (proceed bool) {
 	// keep-sorted end
 
 	// keep-sorted start
+	case *ast.CompositeLit:
+		return v.visitCompositeLit(n)
 	case *ast.FuncLit:
 		return v.visitFuncLit(c, n)
 	case *ast.ValueSpec:
@@ -97,6 +105,7 @@ func (v *Visitor) nodeFilter() []ast.Node {
 		// Less node types are included at `extended` level to perform a more lenient analysis.
 		nodes = append(nodes,
 			// keep-sorted start ignore_prefixes=nodeN,nodeC
+			nodeN((*Visitor).visitCompositeLit),
 			nodeC((*Visitor).visitFuncLit),
 			nodeN((*Visitor).visitValueSpec),
 			// keep-sorted end
@@ -109,6 +118,8 @@ func (v *Visitor) nodeFilter() []ast.Node {
 		nodes = append(nodes,
 			// keep-sorted start ignore_prefixes=nodeN,nodeC
 			nodeC((*Visitor).visitFuncDecl),
+			nodeN((*Visitor).visitIndex),
+			nodeN((*Visitor).visitMapType),
 			nodeN((*Visitor).visitStar),
 			nodeN((*Visitor).visitStructType),
 			nodeN((*Visitor).visitTypeSpec),
@@ -124,6 +135,7 @@ func (v *Visitor) nodeFilter() []ast.Node {
 			nodeN((*Visitor).visitBinary),
 			nodeN((*Visitor).visitCall),
 			nodeN((*Visitor).visitFile),
+			nodeN((*Visitor).visitSwitch),
 			// keep-sorted end
 		)
 	}
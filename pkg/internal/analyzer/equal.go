@@ -0,0 +1,153 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analyzer
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+
+	"fillmore-labs.com/zerolint/pkg/internal/analyzer/msg"
+)
+
+// isEqualDecl checks if a method declaration has the signature of an equality method,
+// `Is(error) bool` as used by [errors.Is] or `Equal(T) bool`.
+func isEqualDecl(info *types.Info, funcdecl *ast.FuncDecl) bool {
+	if recv := funcdecl.Recv; recv == nil || len(recv.List) != 1 || len(recv.List[0].Names) > 1 {
+		return false // Not a method
+	}
+
+	switch funcdecl.Name.Name {
+	case "Is":
+		return hasEqualSig(info, funcdecl.Type) && hasErrorParam(info, funcdecl.Type)
+
+	case "Equal":
+		return hasEqualSig(info, funcdecl.Type)
+
+	default:
+		return false // Wrong name
+	}
+}
+
+func hasEqualSig(info *types.Info, sig *ast.FuncType) bool {
+	if sig.Params.NumFields() != 1 || sig.Results.NumFields() != 1 {
+		return false // Wrong number of parameters / results
+	}
+
+	restype := types.Unalias(info.Types[sig.Results.List[0].Type].Type)
+	if b, basic := restype.(*types.Basic); !basic || b.Kind() != types.Bool {
+		return false // Wrong result type
+	}
+
+	return true
+}
+
+// hasErrorParam checks whether the single parameter of sig has type error.
+func hasErrorParam(info *types.Info, sig *ast.FuncType) bool {
+	paramtype := info.Types[sig.Params.List[0].Type].Type
+
+	return paramtype != nil && types.Identical(paramtype, types.Universe.Lookup("error").Type())
+}
+
+// checkEqualMethod reports comparisons of the receiver in the body of an equality method
+// on a pointer to a zero-sized type.
+func (v *Visitor) checkEqualMethod(n *ast.FuncDecl, elem types.Type, valueMethod bool) {
+	if n.Body == nil || len(n.Recv.List[0].Names) != 1 {
+		return
+	}
+
+	recvName := n.Recv.List[0].Names[0]
+
+	recv, ok := v.Diag.TypesInfo().Defs[recvName].(*types.Var)
+	if !ok || recvName.Name == "_" {
+		return
+	}
+
+	ast.Inspect(n.Body, func(node ast.Node) bool {
+		b, ok := node.(*ast.BinaryExpr)
+		if !ok || b.Op != token.EQL && b.Op != token.NEQ {
+			return true
+		}
+
+		var other ast.Expr
+
+		switch {
+		case v.isVar(b.X, recv):
+			other = b.Y
+
+		case v.isVar(b.Y, recv):
+			other = b.X
+
+		default:
+			return true
+		}
+
+		v.ignoreCmp(b)
+
+		cM := msg.Formatf(msg.CatEqualMethod, valueMethod,
+			"method %s compares receiver %q, a pointer to zero-size type %q", n.Name.Name, recvName.Name, elem)
+
+		var fixes []analysis.SuggestedFix
+		if b.Op == token.EQL && v.isSingleReturn(n.Body, b) {
+			fixes = v.assertParam(n, other)
+		}
+
+		v.Diag.Report(b, cM, fixes)
+
+		return true
+	})
+}
+
+// isVar checks if x is an identifier referring to obj.
+func (v *Visitor) isVar(x ast.Expr, obj *types.Var) bool {
+	id, ok := ast.Unparen(x).(*ast.Ident)
+
+	return ok && v.Diag.TypesInfo().Uses[id] == obj
+}
+
+// isSingleReturn checks if body consists only of `return x`.
+func (*Visitor) isSingleReturn(body *ast.BlockStmt, x ast.Expr) bool {
+	if len(body.List) != 1 {
+		return false
+	}
+
+	r, ok := body.List[0].(*ast.ReturnStmt)
+
+	return ok && len(r.Results) == 1 && ast.Unparen(r.Results[0]) == x
+}
+
+// assertParam suggests replacing the method body with a type assertion of the interface parameter x
+// to the receiver type, if possible.
+func (v *Visitor) assertParam(n *ast.FuncDecl, x ast.Expr) []analysis.SuggestedFix {
+	id, ok := ast.Unparen(x).(*ast.Ident)
+	if !ok {
+		return nil
+	}
+
+	param, ok := v.Diag.TypesInfo().Uses[id].(*types.Var)
+	if !ok || !types.IsInterface(param.Type()) {
+		return nil
+	}
+
+	if names := n.Type.Params.List[0].Names; len(names) != 1 || v.Diag.TypesInfo().Defs[names[0]] != param {
+		return nil // Not the method parameter
+	}
+
+	return v.Diag.AssertType(n.Body, id, n.Recv.List[0].Type)
+}
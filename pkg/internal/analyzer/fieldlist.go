@@ -19,6 +19,8 @@ package analyzer
 import (
 	"go/ast"
 
+	"golang.org/x/tools/go/analysis"
+
 	"fillmore-labs.com/zerolint/pkg/internal/analyzer/msg"
 )
 
@@ -34,11 +36,20 @@ func (v *Visitor) checkFieldList(n *ast.FieldList, skipNamed bool, formatter msg
 			continue // check only embedded types
 		}
 
+		if v.flagSeen(field.Type) {
+			continue // reported as boolean flag
+		}
+
 		t := v.Diag.TypesInfo().TypeOf(field.Type)
 		if elem, valueMethod, zeroSized := v.Check.ZeroSizedTypePointer(t); zeroSized {
 			cM := msg.FormatMessage(formatter, elem, valueMethod, field.Names)
-			fixes := v.removeStar(field.Type)
-			v.Diag.Report(field, cM, fixes)
+
+			var fixes []analysis.SuggestedFix
+			if !v.pointerKept(ast.Unparen(field.Type)) { // E.g. the finalizer parameter of runtime.SetFinalizer.
+				fixes = v.removeStarOf(field.Type, field.Names, nil, elem)
+			}
+
+			v.Diag.Report(field, cM, fixes, v.typeAlternatives(elem))
 		}
 	}
 }
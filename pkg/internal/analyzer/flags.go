@@ -0,0 +1,349 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analyzer
+
+import (
+	"cmp"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"slices"
+
+	"golang.org/x/tools/go/ast/edge"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"fillmore-labs.com/zerolint/pkg/internal/analyzer/msg"
+	"fillmore-labs.com/zerolint/pkg/internal/diag"
+)
+
+// boolFlag is a variable or field of pointer to zero-sized type that might only be used as a boolean flag.
+type boolFlag struct {
+	name        *ast.Ident
+	field       bool
+	elem        types.Type
+	valueMethod bool
+	uses        diag.BoolFlag
+	disproved   bool
+}
+
+// checkFlags finds unexported fields and variables of pointers to zero-sized types that are only set
+// with `&T{}`, `new(T)` or nil and compared with nil, so they are used like a bool.
+// Since this requires examining the whole package, flags are reported here, before the AST is visited,
+// and the diagnostics for their declarations and values are suppressed.
+func (v *Visitor) checkFlags(root inspector.Cursor) {
+	flags := make(map[*types.Var]*boolFlag)
+
+	for c := range root.Children() {
+		if f, ok := c.Node().(*ast.File); !ok || !v.Generated && ast.IsGenerated(f) {
+			continue // Uses in generated files are still checked below.
+		}
+
+		for c := range c.Preorder((*ast.StructType)(nil), (*ast.ValueSpec)(nil), (*ast.AssignStmt)(nil)) {
+			switch n := c.Node().(type) {
+			case *ast.StructType:
+				v.declareFlagFields(n, flags)
+
+			case *ast.ValueSpec:
+				v.declareFlagVar(n, flags)
+
+			case *ast.AssignStmt:
+				v.declareFlagDefines(n, flags)
+			}
+		}
+	}
+
+	if len(flags) == 0 {
+		return
+	}
+
+	for c := range root.Preorder((*ast.Ident)(nil), (*ast.CompositeLit)(nil)) {
+		switch n := c.Node().(type) {
+		case *ast.Ident:
+			obj, ok := v.Diag.TypesInfo().Uses[n].(*types.Var)
+			if !ok {
+				continue
+			}
+
+			if f, ok := flags[obj.Origin()]; ok && !f.disproved {
+				f.disproved = !v.flagUse(c, f)
+			}
+
+		case *ast.CompositeLit:
+			disproveUnkeyed(v.Diag.TypesInfo(), n, flags)
+		}
+	}
+
+	found := make([]*boolFlag, 0, len(flags))
+
+	for _, f := range flags {
+		if !f.disproved && len(f.uses.Compares) > 0 {
+			found = append(found, f)
+		}
+	}
+
+	slices.SortFunc(found, func(a, b *boolFlag) int { return cmp.Compare(a.name.Pos(), b.name.Pos()) })
+
+	for _, f := range found {
+		v.reportFlag(f)
+	}
+}
+
+// declareFlagFields adds unexported, untagged struct fields of pointers to zero-sized types as candidates.
+func (v *Visitor) declareFlagFields(n *ast.StructType, flags map[*types.Var]*boolFlag) {
+	for _, field := range n.Fields.List {
+		if len(field.Names) != 1 || field.Tag != nil {
+			continue // Embedded, multiple names or possibly used by reflection.
+		}
+
+		name := field.Names[0]
+
+		obj, ok := v.Diag.TypesInfo().Defs[name].(*types.Var)
+		if !ok || obj.Exported() || name.Name == "_" {
+			continue
+		}
+
+		if f, ok := v.newFlag(name, obj, field.Type); ok {
+			f.field = true
+			flags[obj] = f
+		}
+	}
+}
+
+// declareFlagVar adds a variable declared as pointer to a zero-sized type as candidate.
+func (v *Visitor) declareFlagVar(n *ast.ValueSpec, flags map[*types.Var]*boolFlag) {
+	if len(n.Names) != 1 || len(n.Values) > 1 {
+		return
+	}
+
+	name := n.Names[0]
+
+	obj, ok := v.Diag.TypesInfo().Defs[name].(*types.Var)
+	if !ok || name.Name == "_" || obj.Exported() && obj.Parent() == obj.Pkg().Scope() {
+		return
+	}
+
+	f, ok := v.newFlag(name, obj, n.Type)
+	if !ok {
+		return
+	}
+
+	if len(n.Values) == 1 && !v.flagValue(n.Values[0], f) {
+		return
+	}
+
+	flags[obj] = f
+}
+
+// declareFlagDefines adds variables defined with `x := &T{}` as candidates.
+func (v *Visitor) declareFlagDefines(n *ast.AssignStmt, flags map[*types.Var]*boolFlag) {
+	if n.Tok != token.DEFINE || len(n.Lhs) != len(n.Rhs) {
+		return
+	}
+
+	for i, lhs := range n.Lhs {
+		name, ok := lhs.(*ast.Ident)
+		if !ok {
+			continue
+		}
+
+		obj, ok := v.Diag.TypesInfo().Defs[name].(*types.Var)
+		if !ok || name.Name == "_" {
+			continue // Not a new variable.
+		}
+
+		if f, ok := v.newFlag(name, obj, nil); ok && v.flagValue(n.Rhs[i], f) {
+			flags[obj] = f
+		}
+	}
+}
+
+// newFlag creates a flag candidate when obj is a pointer to a zero-sized type.
+func (v *Visitor) newFlag(name *ast.Ident, obj *types.Var, typ ast.Expr) (*boolFlag, bool) {
+	elem, valueMethod, zeroSized := v.Check.ZeroSizedTypePointer(obj.Type())
+	if !zeroSized {
+		return nil, false
+	}
+
+	return &boolFlag{name: name, elem: elem, valueMethod: valueMethod, uses: diag.BoolFlag{Type: typ}}, true
+}
+
+// flagValue records x as value of the flag, when it is `&T{}`, `new(T)` or nil.
+func (v *Visitor) flagValue(x ast.Expr, f *boolFlag) bool {
+	if v.Diag.TypesInfo().Types[x].IsNil() {
+		f.uses.Clear = append(f.uses.Clear, x)
+
+		return true
+	}
+
+	if _, ok := v.sentinelType(x); ok {
+		f.uses.Set = append(f.uses.Set, x)
+
+		return true
+	}
+
+	return false
+}
+
+// flagUse records the use of a flag candidate at the identifier c,
+// reporting false if the flag is used for anything else than setting, clearing or comparing with nil.
+func (v *Visitor) flagUse(c inspector.Cursor, f *boolFlag) bool {
+	operand := c
+	if kind, _ := c.ParentEdge(); kind == edge.SelectorExpr_Sel {
+		operand = c.Parent() // x.flag
+	}
+
+	switch kind, i := operand.ParentEdge(); kind {
+	case edge.BinaryExpr_X, edge.BinaryExpr_Y: // flag != nil
+		b, _ := operand.Parent().Node().(*ast.BinaryExpr)
+		if b.Op != token.EQL && b.Op != token.NEQ {
+			return false
+		}
+
+		other := b.Y
+		if kind == edge.BinaryExpr_Y {
+			other = b.X
+		}
+
+		if !v.Diag.TypesInfo().Types[other].IsNil() {
+			return false
+		}
+
+		f.uses.Compares = append(f.uses.Compares, b)
+
+		return true
+
+	case edge.AssignStmt_Lhs: // flag = &T{}
+		a, _ := operand.Parent().Node().(*ast.AssignStmt)
+		if a.Tok != token.ASSIGN && a.Tok != token.DEFINE || len(a.Lhs) != len(a.Rhs) {
+			return false
+		}
+
+		return v.flagValue(a.Rhs[i], f)
+
+	case edge.KeyValueExpr_Key: // S{flag: &T{}}
+		kv, _ := operand.Parent().Node().(*ast.KeyValueExpr)
+
+		return f.field && v.flagValue(kv.Value, f)
+
+	default:
+		return false
+	}
+}
+
+// disproveUnkeyed disproves flag candidates set in unkeyed struct literals.
+func disproveUnkeyed(info *types.Info, n *ast.CompositeLit, flags map[*types.Var]*boolFlag) {
+	t, ok := unkeyedType(info, n)
+	if !ok {
+		return
+	}
+
+	for field := range t.Underlying().(*types.Struct).Fields() {
+		if f, ok := flags[field.Origin()]; ok {
+			f.disproved = true
+		}
+	}
+}
+
+// unkeyedType returns the type of n, when n is a non-empty unkeyed struct literal.
+func unkeyedType(info *types.Info, n *ast.CompositeLit) (types.Type, bool) {
+	if len(n.Elts) == 0 {
+		return nil, false
+	}
+
+	if _, ok := n.Elts[0].(*ast.KeyValueExpr); ok {
+		return nil, false
+	}
+
+	t := info.TypeOf(n)
+	if t == nil {
+		return nil, false
+	}
+
+	if p, ok := t.Underlying().(*types.Pointer); ok && n.Type == nil {
+		t = p.Elem() // Elided &T in []*T{{...}}.
+	}
+
+	if _, ok := t.Underlying().(*types.Struct); !ok {
+		return nil, false
+	}
+
+	return t, true
+}
+
+// hasUnkeyedLiterals checks whether the package has non-empty unkeyed literals of the struct type t,
+// which break when fields are added or reordered.
+func (v *Visitor) hasUnkeyedLiterals(t types.Type) bool {
+	for c := range v.root.Preorder((*ast.CompositeLit)(nil)) {
+		u, ok := unkeyedType(v.Diag.TypesInfo(), c.Node().(*ast.CompositeLit))
+		if !ok {
+			continue
+		}
+
+		if n, ok := u.(*types.Named); ok {
+			u = n.Origin() // Instantiations of a generic type.
+		}
+
+		if types.Identical(u, t) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// declaredType returns the type declared with the type expression x, or the type of x if it is not declared.
+func (v *Visitor) declaredType(x ast.Expr) types.Type {
+	if c, ok := v.root.FindByPos(x.Pos(), x.End()); ok {
+		if k, _ := c.ParentEdge(); k == edge.TypeSpec_Type {
+			spec, _ := c.Parent().Node().(*ast.TypeSpec)
+			if obj := v.Diag.TypesInfo().Defs[spec.Name]; obj != nil {
+				return obj.Type()
+			}
+		}
+	}
+
+	return v.Diag.TypesInfo().TypeOf(x)
+}
+
+// reportFlag reports a flag, suggesting to use bool instead.
+func (v *Visitor) reportFlag(f *boolFlag) {
+	if s, ok := f.uses.Type.(*ast.StarExpr); ok {
+		v.ignoreStar(s)
+	}
+
+	if f.uses.Type != nil {
+		v.ignoreFlag(f.uses.Type)
+	}
+
+	for _, x := range f.uses.Set {
+		v.ignoreFlag(ast.Unparen(x))
+	}
+
+	cM := msg.FlagMessage(f.elem, f.valueMethod, f.field, f.name.Name)
+	fixes := v.Diag.UseBool(f.uses)
+	v.Diag.Report(f.name, cM, fixes)
+}
+
+// ignoreFlag ignores a node rewritten by a flag fix in further processing.
+func (v *Visitor) ignoreFlag(n ast.Node) {
+	v.seenFlags.Add(n.Pos())
+}
+
+// flagSeen checks whether a node is rewritten by a flag fix.
+func (v *Visitor) flagSeen(n ast.Node) bool {
+	return v.seenFlags.Contains(n.Pos())
+}
@@ -25,6 +25,17 @@ const (
 	funcDecode
 	funcCmp0
 	funcCmp1
+	funcKey0
+	funcKey1
+	funcIdentity0
+	funcMapKey0
+	funcAs1
+	funcAs2
+	funcPrint0
+	funcPrint1
+	funcPrintf0
+	funcPrintf1
+	funcAddress
 )
 
 // Since we have a lot of hardcoded libraries here, a check by signature might be a better heuristic.
@@ -52,26 +63,81 @@ var functions = map[typeutil.FuncName]funcType{ //nolint:gochecknoglobals
 	{Path: "github.com/stretchr/testify/require", Receiver: "Assertions", Name: "ErrorIsf", Ptr: true}:    funcCmp0,
 	{Path: "github.com/stretchr/testify/require", Receiver: "Assertions", Name: "NotErrorIs", Ptr: true}:  funcCmp0,
 	{Path: "github.com/stretchr/testify/require", Receiver: "Assertions", Name: "NotErrorIsf", Ptr: true}: funcCmp0,
-	{Path: "errors", Name: "As"}:                                                                          funcDecode,
-	{Path: "golang.org/x/exp/errors", Name: "As"}:                                                         funcDecode,
-	{Path: "golang.org/x/xerrors", Name: "As"}:                                                            funcDecode,
-	{Path: "github.com/pkg/errors", Name: "As"}:                                                           funcDecode,
-	{Path: "github.com/stretchr/testify/assert", Name: "ErrorAs"}:                                         funcDecode,
-	{Path: "github.com/stretchr/testify/assert", Name: "ErrorAsf"}:                                        funcDecode,
-	{Path: "github.com/stretchr/testify/assert", Name: "NotErrorAs"}:                                      funcDecode,
-	{Path: "github.com/stretchr/testify/assert", Name: "NotErrorAsf"}:                                     funcDecode,
-	{Path: "github.com/stretchr/testify/require", Name: "ErrorAs"}:                                        funcDecode,
-	{Path: "github.com/stretchr/testify/require", Name: "ErrorAsf"}:                                       funcDecode,
-	{Path: "github.com/stretchr/testify/require", Name: "NotErrorAs"}:                                     funcDecode,
-	{Path: "github.com/stretchr/testify/require", Name: "NotErrorAsf"}:                                    funcDecode,
-	{Path: "github.com/stretchr/testify/assert", Receiver: "Assertions", Name: "ErrorAs", Ptr: true}:      funcDecode,
-	{Path: "github.com/stretchr/testify/assert", Receiver: "Assertions", Name: "ErrorAsf", Ptr: true}:     funcDecode,
-	{Path: "github.com/stretchr/testify/assert", Receiver: "Assertions", Name: "NotErrorAs", Ptr: true}:   funcDecode,
-	{Path: "github.com/stretchr/testify/assert", Receiver: "Assertions", Name: "NotErrorAsf", Ptr: true}:  funcDecode,
-	{Path: "github.com/stretchr/testify/require", Receiver: "Assertions", Name: "ErrorAs", Ptr: true}:     funcDecode,
-	{Path: "github.com/stretchr/testify/require", Receiver: "Assertions", Name: "ErrorAsf", Ptr: true}:    funcDecode,
-	{Path: "github.com/stretchr/testify/require", Receiver: "Assertions", Name: "NotErrorAs", Ptr: true}:  funcDecode,
-	{Path: "github.com/stretchr/testify/require", Receiver: "Assertions", Name: "NotErrorAsf", Ptr: true}: funcDecode,
+	{Path: "context", Name: "WithValue"}:                                                                  funcKey1,
+	{Path: "context", Receiver: "Context", Name: "Value"}:                                                 funcKey0,
+	{Path: "runtime", Name: "SetFinalizer"}:                                                               funcIdentity0,
+	{Path: "runtime", Name: "AddCleanup"}:                                                                 funcIdentity0,
+	{Path: "weak", Name: "Make"}:                                                                          funcIdentity0,
+	{Path: "unique", Name: "Make"}:                                                                        funcIdentity0,
+	{Path: "sync", Receiver: "Map", Name: "Load", Ptr: true}:                                              funcMapKey0,
+	{Path: "sync", Receiver: "Map", Name: "Store", Ptr: true}:                                             funcMapKey0,
+	{Path: "sync", Receiver: "Map", Name: "LoadOrStore", Ptr: true}:                                       funcMapKey0,
+	{Path: "sync", Receiver: "Map", Name: "LoadAndDelete", Ptr: true}:                                     funcMapKey0,
+	{Path: "sync", Receiver: "Map", Name: "Delete", Ptr: true}:                                            funcMapKey0,
+	{Path: "sync", Receiver: "Map", Name: "Swap", Ptr: true}:                                              funcMapKey0,
+	{Path: "sync", Receiver: "Map", Name: "CompareAndSwap", Ptr: true}:                                    funcMapKey0,
+	{Path: "sync", Receiver: "Map", Name: "CompareAndDelete", Ptr: true}:                                  funcMapKey0,
+	{Path: "fmt", Name: "Print"}:                                                                          funcPrint0,
+	{Path: "fmt", Name: "Println"}:                                                                        funcPrint0,
+	{Path: "fmt", Name: "Sprint"}:                                                                         funcPrint0,
+	{Path: "fmt", Name: "Sprintln"}:                                                                       funcPrint0,
+	{Path: "fmt", Name: "Fprint"}:                                                                         funcPrint1,
+	{Path: "fmt", Name: "Fprintln"}:                                                                       funcPrint1,
+	{Path: "fmt", Name: "Append"}:                                                                         funcPrint1,
+	{Path: "fmt", Name: "Appendln"}:                                                                       funcPrint1,
+	{Path: "fmt", Name: "Printf"}:                                                                         funcPrintf0,
+	{Path: "fmt", Name: "Sprintf"}:                                                                        funcPrintf0,
+	{Path: "fmt", Name: "Errorf"}:                                                                         funcPrintf0,
+	{Path: "fmt", Name: "Fprintf"}:                                                                        funcPrintf1,
+	{Path: "fmt", Name: "Appendf"}:                                                                        funcPrintf1,
+	{Path: "log", Name: "Print"}:                                                                          funcPrint0,
+	{Path: "log", Name: "Println"}:                                                                        funcPrint0,
+	{Path: "log", Name: "Fatal"}:                                                                          funcPrint0,
+	{Path: "log", Name: "Fatalln"}:                                                                        funcPrint0,
+	{Path: "log", Name: "Panic"}:                                                                          funcPrint0,
+	{Path: "log", Name: "Panicln"}:                                                                        funcPrint0,
+	{Path: "log", Name: "Printf"}:                                                                         funcPrintf0,
+	{Path: "log", Name: "Fatalf"}:                                                                         funcPrintf0,
+	{Path: "log", Name: "Panicf"}:                                                                         funcPrintf0,
+	{Path: "log", Receiver: "Logger", Name: "Print", Ptr: true}:                                           funcPrint0,
+	{Path: "log", Receiver: "Logger", Name: "Println", Ptr: true}:                                         funcPrint0,
+	{Path: "log", Receiver: "Logger", Name: "Fatal", Ptr: true}:                                           funcPrint0,
+	{Path: "log", Receiver: "Logger", Name: "Fatalln", Ptr: true}:                                         funcPrint0,
+	{Path: "log", Receiver: "Logger", Name: "Panic", Ptr: true}:                                           funcPrint0,
+	{Path: "log", Receiver: "Logger", Name: "Panicln", Ptr: true}:                                         funcPrint0,
+	{Path: "log", Receiver: "Logger", Name: "Printf", Ptr: true}:                                          funcPrintf0,
+	{Path: "log", Receiver: "Logger", Name: "Fatalf", Ptr: true}:                                          funcPrintf0,
+	{Path: "log", Receiver: "Logger", Name: "Panicf", Ptr: true}:                                          funcPrintf0,
+	{Path: "testing", Receiver: "common", Name: "Log", Ptr: true}:                                         funcPrint0,
+	{Path: "testing", Receiver: "common", Name: "Error", Ptr: true}:                                       funcPrint0,
+	{Path: "testing", Receiver: "common", Name: "Fatal", Ptr: true}:                                       funcPrint0,
+	{Path: "testing", Receiver: "common", Name: "Skip", Ptr: true}:                                        funcPrint0,
+	{Path: "testing", Receiver: "common", Name: "Logf", Ptr: true}:                                        funcPrintf0,
+	{Path: "testing", Receiver: "common", Name: "Errorf", Ptr: true}:                                      funcPrintf0,
+	{Path: "testing", Receiver: "common", Name: "Fatalf", Ptr: true}:                                      funcPrintf0,
+	{Path: "testing", Receiver: "common", Name: "Skipf", Ptr: true}:                                       funcPrintf0,
+	{Path: "reflect", Receiver: "Value", Name: "Pointer"}:                                                 funcAddress,
+	{Path: "reflect", Receiver: "Value", Name: "UnsafePointer"}:                                           funcAddress,
+	{Path: "errors", Name: "As"}:                                                                          funcAs1,
+	{Path: "golang.org/x/exp/errors", Name: "As"}:                                                         funcAs1,
+	{Path: "golang.org/x/xerrors", Name: "As"}:                                                            funcAs1,
+	{Path: "github.com/pkg/errors", Name: "As"}:                                                           funcAs1,
+	{Path: "github.com/stretchr/testify/assert", Name: "ErrorAs"}:                                         funcAs2,
+	{Path: "github.com/stretchr/testify/assert", Name: "ErrorAsf"}:                                        funcAs2,
+	{Path: "github.com/stretchr/testify/assert", Name: "NotErrorAs"}:                                      funcAs2,
+	{Path: "github.com/stretchr/testify/assert", Name: "NotErrorAsf"}:                                     funcAs2,
+	{Path: "github.com/stretchr/testify/require", Name: "ErrorAs"}:                                        funcAs2,
+	{Path: "github.com/stretchr/testify/require", Name: "ErrorAsf"}:                                       funcAs2,
+	{Path: "github.com/stretchr/testify/require", Name: "NotErrorAs"}:                                     funcAs2,
+	{Path: "github.com/stretchr/testify/require", Name: "NotErrorAsf"}:                                    funcAs2,
+	{Path: "github.com/stretchr/testify/assert", Receiver: "Assertions", Name: "ErrorAs", Ptr: true}:      funcAs1,
+	{Path: "github.com/stretchr/testify/assert", Receiver: "Assertions", Name: "ErrorAsf", Ptr: true}:     funcAs1,
+	{Path: "github.com/stretchr/testify/assert", Receiver: "Assertions", Name: "NotErrorAs", Ptr: true}:   funcAs1,
+	{Path: "github.com/stretchr/testify/assert", Receiver: "Assertions", Name: "NotErrorAsf", Ptr: true}:  funcAs1,
+	{Path: "github.com/stretchr/testify/require", Receiver: "Assertions", Name: "ErrorAs", Ptr: true}:     funcAs1,
+	{Path: "github.com/stretchr/testify/require", Receiver: "Assertions", Name: "ErrorAsf", Ptr: true}:    funcAs1,
+	{Path: "github.com/stretchr/testify/require", Receiver: "Assertions", Name: "NotErrorAs", Ptr: true}:  funcAs1,
+	{Path: "github.com/stretchr/testify/require", Receiver: "Assertions", Name: "NotErrorAsf", Ptr: true}: funcAs1,
 	{Path: "encoding/json", Name: "Unmarshal"}:                                                            funcDecode,
 	{Path: "encoding/json", Receiver: "Decoder", Name: "Decode", Ptr: true}:                               funcDecode,
 	{Path: "github.com/ghodss/yaml", Name: "Unmarshal"}:                                                   funcDecode,
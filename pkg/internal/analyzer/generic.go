@@ -0,0 +1,114 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analyzer
+
+import (
+	"go/ast"
+	"go/types"
+
+	"fillmore-labs.com/zerolint/pkg/internal/analyzer/msg"
+	"fillmore-labs.com/zerolint/pkg/internal/typeutil"
+)
+
+// visitGenericCall checks calls of generic functions like slices.Contains, where a comparable type parameter
+// is instantiated with a pointer to a zero-sized type, so the function compares these pointers.
+func (v *Visitor) visitGenericCall(n *ast.CallExpr, fun *types.Func) {
+	id := funcIdent(n.Fun)
+	if id == nil {
+		return
+	}
+
+	funcName := typeutil.NewFuncName(fun)
+	if _, ok := functions[funcName]; ok { // Handled by visitCallFunc.
+		return
+	}
+
+	inst, ok := v.Diag.TypesInfo().Instances[id]
+	if !ok { // Not a generic function.
+		return
+	}
+
+	tparams := fun.Origin().Signature().TypeParams()
+	if tparams.Len() != inst.TypeArgs.Len() { // should not happen
+		v.Diag.LogErrorf(n, "Type argument mismatch for %s", fun.Name())
+
+		return
+	}
+
+	for i := range tparams.Len() {
+		tparam := tparams.At(i)
+		if !isComparable(tparam.Constraint()) {
+			continue
+		}
+
+		elem, valueMethod, zeroSized := v.Check.ZeroSizedTypePointer(inst.TypeArgs.At(i))
+		if !zeroSized {
+			continue
+		}
+
+		cM := msg.Formatf(msg.CatComparisonGeneric, valueMethod,
+			"%s compares pointers to zero-size type %q as comparable type parameter %s",
+			funcName, elem, tparam.Obj().Name())
+		v.Diag.Report(n, cM, nil)
+
+		return
+	}
+}
+
+// funcIdent returns the identifier naming the called function, skipping explicit instantiations.
+func funcIdent(fun ast.Expr) *ast.Ident {
+	switch f := ast.Unparen(fun).(type) {
+	case *ast.Ident:
+		return f
+
+	case *ast.SelectorExpr:
+		return f.Sel
+
+	case *ast.IndexExpr: // Eq[*T](...)
+		return funcIdent(f.X)
+
+	case *ast.IndexListExpr: // slices.Index[[]*T, *T](...)
+		return funcIdent(f.X)
+
+	default:
+		return nil
+	}
+}
+
+// comparableType is the predeclared comparable interface.
+var comparableType = types.Universe.Lookup("comparable").Type() //nolint:gochecknoglobals
+
+// isComparable reports whether the constraint is or embeds comparable.
+// Constraints like ~[]E or *T also restrict to comparable types, but do not suggest that values are compared.
+func isComparable(constraint types.Type) bool {
+	if types.Identical(constraint, comparableType) {
+		return true
+	}
+
+	iface, ok := constraint.Underlying().(*types.Interface)
+	if !ok {
+		return false
+	}
+
+	for i := range iface.NumEmbeddeds() {
+		if isComparable(iface.EmbeddedType(i)) {
+			return true
+		}
+	}
+
+	return false
+}
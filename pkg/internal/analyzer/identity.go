@@ -0,0 +1,229 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analyzer
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/ast/inspector"
+
+	"fillmore-labs.com/zerolint/pkg/internal/analyzer/msg"
+	"fillmore-labs.com/zerolint/pkg/internal/typeutil"
+)
+
+// visitIdentity analyzes objects passed to functions that depend on the identity of a pointer,
+// like runtime.SetFinalizer, runtime.AddCleanup, weak.Make or unique.Make.
+//
+// Pointers to zero-sized types may all point to the same address (runtime.zerobase), so
+// finalizers and cleanups may never run and weak pointers may never become nil.
+func (v *Visitor) visitIdentity(x ast.Expr, funcName typeutil.FuncName) bool {
+	tv, ok := v.Diag.TypesInfo().Types[x]
+	if !ok { // should not happen
+		v.Diag.LogErrorf(x, "Can't find identity argument type")
+
+		return true
+	}
+
+	elem, valueMethod, zeroSized := v.Check.ZeroSizedTypePointer(tv.Type)
+	if !zeroSized {
+		return true
+	}
+
+	cM := msg.IdentityMessage(funcName.String(), elem, valueMethod)
+	v.Diag.Report(x, cM, nil)
+
+	return true
+}
+
+// checkKeptPointers finds allocations and declarations of pointers to zero-sized values that must stay pointers,
+// either directly or through a variable: Addresses passed to identity-sensitive functions, keys of maps with
+// pointer keys, since map types are reported without fix, and function results compared with nil by callers.
+// Removing the address breaks these uses, so their diagnostics are reported without fix.
+func (v *Visitor) checkKeptPointers(root inspector.Cursor) {
+	info := v.Diag.TypesInfo()
+	vars := make(map[*types.Var]struct{})
+
+	keep := func(x ast.Expr) {
+		switch x := ast.Unparen(x).(type) {
+		case *ast.Ident:
+			if obj, ok := info.Uses[x].(*types.Var); ok {
+				vars[obj] = struct{}{}
+			}
+
+		default:
+			v.keepAddress(x)
+		}
+	}
+
+	nodes := []ast.Node{(*ast.CallExpr)(nil), (*ast.IndexExpr)(nil), (*ast.CompositeLit)(nil), (*ast.FuncDecl)(nil)}
+	for c := range root.Preorder(nodes...) {
+		switch n := c.Node().(type) {
+		case *ast.FuncDecl:
+			v.keepNilCheckedResults(c, n)
+
+		case *ast.CallExpr:
+			if isDelete(info, n) && hasPointerKey(info.TypeOf(n.Args[0])) {
+				keep(n.Args[1])
+
+				continue
+			}
+
+			fun, methodExpr, ok := typeutil.FuncOf(info, n.Fun)
+			if !ok || methodExpr || len(n.Args) == 0 || functions[typeutil.NewFuncName(fun)] != funcIdentity0 {
+				continue
+			}
+
+			keep(n.Args[0])
+
+			// The finalizer of runtime.SetFinalizer must accept the pointer.
+			if fun.Name() == "SetFinalizer" && len(n.Args) > 1 {
+				if f, ok := ast.Unparen(n.Args[1]).(*ast.FuncLit); ok && len(f.Type.Params.List) > 0 {
+					v.keepAddress(f.Type.Params.List[0].Type)
+				}
+			}
+
+		case *ast.IndexExpr:
+			if hasPointerKey(info.TypeOf(n.X)) {
+				keep(n.Index)
+			}
+
+		case *ast.CompositeLit:
+			if !hasPointerKey(info.TypeOf(n)) {
+				continue
+			}
+
+			for _, e := range n.Elts {
+				if kv, ok := e.(*ast.KeyValueExpr); ok {
+					keep(kv.Key)
+				}
+			}
+		}
+	}
+
+	if len(vars) == 0 {
+		return
+	}
+
+	for obj := range vars {
+		v.keepDeclaration(obj)
+	}
+
+	for c := range root.Preorder((*ast.AssignStmt)(nil), (*ast.ValueSpec)(nil)) {
+		var lhs, rhs []ast.Expr
+
+		switch n := c.Node().(type) {
+		case *ast.AssignStmt:
+			lhs, rhs = n.Lhs, n.Rhs
+
+		case *ast.ValueSpec:
+			for _, name := range n.Names {
+				lhs = append(lhs, name)
+			}
+
+			rhs = n.Values
+		}
+
+		if len(lhs) != len(rhs) {
+			continue
+		}
+
+		for i, l := range lhs {
+			id, ok := ast.Unparen(l).(*ast.Ident)
+			if !ok {
+				continue
+			}
+
+			obj := info.ObjectOf(id)
+			if obj, ok := obj.(*types.Var); ok {
+				if _, ok := vars[obj]; ok {
+					v.keepAddress(rhs[i])
+				}
+			}
+		}
+	}
+}
+
+// keepDeclaration marks the declared type of a parameter or variable as type that must stay a pointer.
+func (v *Visitor) keepDeclaration(obj *types.Var) {
+	c, ok := v.root.FindByPos(obj.Pos(), obj.Pos())
+	if !ok {
+		return
+	}
+
+	switch n := c.Parent().Node().(type) {
+	case *ast.Field:
+		v.keepAddress(n.Type)
+
+	case *ast.ValueSpec:
+		if n.Type != nil {
+			v.keepAddress(n.Type)
+		}
+	}
+}
+
+// hasPointerKey reports whether t is a map type with pointer keys.
+func hasPointerKey(t types.Type) bool {
+	key, ok := mapKey(t)
+	if !ok {
+		return false
+	}
+
+	_, ok = key.Underlying().(*types.Pointer)
+
+	return ok
+}
+
+// isDelete reports whether n is a call of the builtin delete.
+func isDelete(info *types.Info, n *ast.CallExpr) bool {
+	id, ok := ast.Unparen(n.Fun).(*ast.Ident)
+	if !ok || len(n.Args) != 2 {
+		return false
+	}
+
+	b, ok := info.Uses[id].(*types.Builtin)
+
+	return ok && b.Name() == "delete"
+}
+
+// keepAddress marks x as allocation or type that must stay a pointer, when it is `&T{}`, `new(T)`, `*T` or nil.
+func (v *Visitor) keepAddress(x ast.Expr) {
+	switch x := ast.Unparen(x).(type) {
+	case *ast.Ident:
+		if v.isNil(x) {
+			v.keptPointers.Add(x.Pos())
+		}
+
+	case *ast.StarExpr:
+		v.keptPointers.Add(x.Pos())
+		v.ignoreStar(x) // Reported by the field list without fix.
+
+	case *ast.UnaryExpr:
+		if x.Op == token.AND {
+			v.keptPointers.Add(x.Pos())
+		}
+
+	case *ast.CallExpr:
+		v.keptPointers.Add(x.Pos())
+	}
+}
+
+// pointerKept checks whether n is an allocation or type that must stay a pointer.
+func (v *Visitor) pointerKept(n ast.Node) bool {
+	return v.keptPointers.Contains(n.Pos())
+}
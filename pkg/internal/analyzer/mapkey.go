@@ -0,0 +1,210 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analyzer
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/ast/edge"
+
+	"fillmore-labs.com/zerolint/pkg/internal/analyzer/msg"
+	"fillmore-labs.com/zerolint/pkg/zerolint/level"
+)
+
+// visitMapType checks map types with keys that are pointers to zero-sized types or zero-sized types.
+func (v *Visitor) visitMapType(n *ast.MapType) bool {
+	t := v.Diag.TypesInfo().TypeOf(n.Key)
+	if t == nil { // should not happen
+		v.Diag.LogErrorf(n, "Can't find map key type")
+
+		return true
+	}
+
+	elem, valueMethod, zeroSized := v.Check.ZeroSizedTypePointer(t)
+	if !zeroSized {
+		if v.Level.AtLeast(level.Extended) {
+			v.visitZeroSizedKey(n, t)
+		}
+
+		return true
+	}
+
+	if s, ok := ast.Unparen(n.Key).(*ast.StarExpr); ok {
+		v.ignoreStar(s) // Reported here, not as a plain pointer type.
+	}
+
+	cM := msg.Formatf(msg.CatMapKey, valueMethod, "map key is pointer to zero-size type %q", elem)
+	v.Diag.Report(n.Key, cM, nil)
+
+	return true
+}
+
+// visitZeroSizedKey checks map types with zero-sized keys, which hold at most one entry.
+func (v *Visitor) visitZeroSizedKey(n *ast.MapType, t types.Type) {
+	valueMethod, zeroSized := v.Check.ZeroSizedType(t)
+	if !zeroSized {
+		return
+	}
+
+	cM := msg.Formatf(msg.CatMapKeyZeroSized, valueMethod,
+		"map key is zero-sized type %q, so the map holds at most one entry", t)
+	v.Diag.Report(n.Key, cM, nil)
+}
+
+// visitMakeMap checks make(map[K]V) initializing a variable that is assigned more than one entry,
+// where K is a zero-sized type.
+func (v *Visitor) visitMakeMap(n *ast.CallExpr) bool {
+	if len(n.Args) == 0 {
+		return true
+	}
+
+	key, ok := mapKey(v.Diag.TypesInfo().TypeOf(n.Args[0]))
+	if !ok {
+		return true
+	}
+
+	name, ok := v.initializedVar(n)
+	if !ok {
+		return true
+	}
+
+	inserts := v.countInserts(v.Diag.TypesInfo().Defs[name])
+	if inserts < 2 {
+		return true
+	}
+
+	valueMethod, zeroSized := v.Check.ZeroSizedType(key)
+	if !zeroSized {
+		return true
+	}
+
+	cM := msg.Formatf(msg.CatMapKeyZeroSized, valueMethod,
+		"map with zero-sized key type %q has %d insertions, but holds at most one entry", key, inserts)
+	v.Diag.Report(n, cM, nil)
+
+	return true
+}
+
+// countInserts counts the index assignments `m[k] = v` to the map variable obj.
+func (v *Visitor) countInserts(obj types.Object) int {
+	if obj == nil {
+		return 0
+	}
+
+	inserts := 0
+
+	for _, id := range v.usesOf(obj) {
+		c, ok := v.root.FindByPos(id.Pos(), id.End())
+		if !ok {
+			continue
+		}
+
+		if k, _ := unparen(c).ParentEdge(); k != edge.IndexExpr_X {
+			continue
+		}
+
+		if k, _ := unparen(unparen(c).Parent()).ParentEdge(); k == edge.AssignStmt_Lhs {
+			inserts++
+		}
+	}
+
+	return inserts
+}
+
+// visitMapLit checks map literals with more than one entry, where the key is a zero-sized type.
+// All keys are equal, so only the last entry is retained.
+func (v *Visitor) visitMapLit(n *ast.CompositeLit) bool {
+	if len(n.Elts) < 2 {
+		return true
+	}
+
+	key, ok := mapKey(v.Diag.TypesInfo().TypeOf(n))
+	if !ok {
+		return true
+	}
+
+	valueMethod, zeroSized := v.Check.ZeroSizedType(key)
+	if !zeroSized {
+		return true
+	}
+
+	cM := msg.Formatf(msg.CatMapKeyZeroSized, valueMethod,
+		"map literal with zero-sized key type %q has %d entries, but holds at most one", key, len(n.Elts))
+	v.Diag.Report(n.Elts[1], cM, nil)
+
+	return true
+}
+
+// mapKey returns the key type if t is a map type.
+func mapKey(t types.Type) (types.Type, bool) {
+	if t == nil {
+		return nil, false
+	}
+
+	m, ok := t.Underlying().(*types.Map)
+	if !ok {
+		return nil, false
+	}
+
+	return m.Key(), true
+}
+
+// visitIndex checks map index expressions where the key type is a pointer to a zero-sized type.
+func (v *Visitor) visitIndex(n *ast.IndexExpr) bool {
+	t := v.Diag.TypesInfo().TypeOf(n.X)
+	if t == nil { // should not happen
+		v.Diag.LogErrorf(n, "Can't find index type")
+
+		return true
+	}
+
+	m, ok := t.Underlying().(*types.Map)
+	if !ok { // Not a map access, maybe a slice or generic instantiation.
+		return true
+	}
+
+	elem, valueMethod, zeroSized := v.Check.ZeroSizedTypePointer(m.Key())
+	if !zeroSized {
+		return true
+	}
+
+	cM := msg.Formatf(msg.CatMapKey, valueMethod, "map index is pointer to zero-size type %q", elem)
+	v.Diag.Report(n.Index, cM, nil)
+
+	return true
+}
+
+// visitSyncMapKey checks keys of sync.Map operations that are pointers to zero-sized types.
+func (v *Visitor) visitSyncMapKey(x ast.Expr) bool {
+	tv, ok := v.Diag.TypesInfo().Types[x]
+	if !ok { // should not happen
+		v.Diag.LogErrorf(x, "Can't find sync.Map key type")
+
+		return true
+	}
+
+	elem, valueMethod, zeroSized := v.Check.ZeroSizedTypePointer(tv.Type)
+	if !zeroSized {
+		return true
+	}
+
+	cM := msg.Formatf(msg.CatMapKey, valueMethod, "sync.Map key is pointer to zero-size type %q", elem)
+	v.Diag.Report(x, cM, nil)
+
+	return true
+}
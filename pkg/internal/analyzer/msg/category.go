@@ -22,26 +22,42 @@ import "fillmore-labs.com/zerolint/pkg/internal/diag"
 const (
 	// keep-sorted start
 	CatAddress             diag.Category = "add"
+	CatAddressValue        diag.Category = "adr"
 	CatArgumentNil         diag.Category = "arg"
+	CatAsTarget            diag.Category = "tgt"
 	CatCast                diag.Category = "cst"
 	CatCastNil             diag.Category = "nil"
 	CatCastUnsafe          diag.Category = "cup"
 	CatComparison          diag.Category = "cmp"
+	CatComparisonComposite diag.Category = "cmc"
 	CatComparisonError     diag.Category = "cme"
+	CatComparisonGeneric   diag.Category = "cmg"
 	CatComparisonInterface diag.Category = "cmi"
+	CatComparisonValue     diag.Category = "cmv"
+	CatConstruction        diag.Category = "mix"
+	CatContextKey          diag.Category = "ctx"
 	CatDeref               diag.Category = "der"
+	CatEqualMethod         diag.Category = "eqm"
 	CatError               diag.Category = "err"
+	CatFlag                diag.Category = "flg"
+	CatIdentity            diag.Category = "idn"
+	CatMapKey              diag.Category = "key"
+	CatMapKeyZeroSized     diag.Category = "mzk"
 	CatMethodExpression    diag.Category = "mex"
 	CatNew                 diag.Category = "new"
 	CatParameter           diag.Category = "par"
+	CatPrint               diag.Category = "prt"
 	CatReceiver            diag.Category = "rcv"
 	CatResult              diag.Category = "res"
 	CatReturnNil           diag.Category = "ret"
+	CatSentinel            diag.Category = "snt"
 	CatStarType            diag.Category = "typ"
 	CatStructEmbedded      diag.Category = "emb"
 	CatStructField         diag.Category = "fld"
+	CatStructPadding       diag.Category = "pad"
 	CatTypeAssert          diag.Category = "ast"
 	CatTypeDeclaration     diag.Category = "dcl"
+	CatTypedNil            diag.Category = "tnl"
 	CatVar                 diag.Category = "var"
 	// keep-sorted end
 )
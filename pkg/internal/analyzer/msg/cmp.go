@@ -52,3 +52,10 @@ func ComparisonMessagePointerInterface(
 	return Formatf(CatComparisonInterface, valueMethod,
 		"comparison of pointer to zero-size type %q with interface of type %q", elemTypeString, interfaceTypeString)
 }
+
+// ComparisonMessageComposite generates a diagnostic message for comparing structs or arrays
+// containing a pointer to a zero-sized type.
+func ComparisonMessageComposite(path string, elem types.Type, valueMethod bool) diag.CategorizedMessage {
+	return Formatf(CatComparisonComposite, valueMethod,
+		"comparison includes %q, a pointer to zero-size type %q", path, elem)
+}
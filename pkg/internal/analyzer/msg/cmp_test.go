@@ -131,3 +131,43 @@ func TestComparisonMessagePointerInterface(t *testing.T) {
 		})
 	}
 }
+
+func TestComparisonMessageComposite(t *testing.T) {
+	t.Parallel()
+
+	pkg := types.NewPackage("test", "test")
+	namedStructType := types.NewNamed(types.NewTypeName(token.NoPos, pkg, "MyStruct", nil), types.NewStruct(nil, nil), nil)
+
+	testCases := [...]struct {
+		name        string
+		path        string
+		elem        types.Type
+		valueMethod bool
+		want        string
+	}{
+		{
+			name:        "struct field",
+			path:        "cfg.opts.marker",
+			elem:        namedStructType,
+			valueMethod: false,
+			want:        `comparison includes "cfg.opts.marker", a pointer to zero-size type "test.MyStruct" (zl:cmc)`,
+		},
+		{
+			name:        "array element",
+			path:        "a[i]",
+			elem:        namedStructType,
+			valueMethod: true,
+			want:        `comparison includes "a[i]", a pointer to zero-size type "test.MyStruct" (zl:cmc+)`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := ComparisonMessageComposite(tc.path, tc.elem, tc.valueMethod); got.Message != tc.want {
+				t.Errorf("ComparisonMessageComposite() = %q, want %q", got.Message, tc.want)
+			}
+		})
+	}
+}
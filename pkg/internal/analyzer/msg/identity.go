@@ -0,0 +1,50 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package msg
+
+import (
+	"go/types"
+
+	"fillmore-labs.com/zerolint/pkg/internal/diag"
+)
+
+// IdentityMessage generates a diagnostic message for passing a pointer to a zero-sized type
+// to an identity-sensitive function, explaining the consequence for the specific function.
+func IdentityMessage(funcName string, elem types.Type, valueMethod bool) diag.CategorizedMessage {
+	var reason string
+
+	switch funcName {
+	case "runtime.SetFinalizer":
+		reason = "the finalizer may never run"
+
+	case "runtime.AddCleanup":
+		reason = "the cleanup may never run"
+
+	case "weak.Make":
+		reason = "the weak pointer may never become nil"
+
+	case "unique.Make":
+		reason = "handles of distinct values may or may not be equal"
+
+	default:
+		reason = "the object has no unique identity"
+	}
+
+	return Formatf(CatIdentity, valueMethod,
+		"%s called with pointer to zero-size type %q: pointers to zero-sized types may share an address, so %s",
+		funcName, elem, reason)
+}
@@ -0,0 +1,57 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package msg_test
+
+import (
+	"go/types"
+	"strings"
+	"testing"
+
+	. "fillmore-labs.com/zerolint/pkg/internal/analyzer/msg"
+)
+
+func TestIdentityMessage(t *testing.T) {
+	t.Parallel()
+
+	structType := types.NewStruct(nil, nil)
+
+	testCases := [...]struct {
+		funcName string
+		want     string
+	}{
+		{"runtime.SetFinalizer", "finalizer may never run"},
+		{"runtime.AddCleanup", "cleanup may never run"},
+		{"weak.Make", "weak pointer may never become nil"},
+		{"unique.Make", "may or may not be equal"},
+		{"example.com/pkg.Other", "no unique identity"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.funcName, func(t *testing.T) {
+			t.Parallel()
+
+			got := IdentityMessage(tc.funcName, structType, false)
+			if !strings.Contains(got.Message, tc.funcName) || !strings.Contains(got.Message, tc.want) {
+				t.Errorf("IdentityMessage() returned %q, does not contain %q and %q", got.Message, tc.funcName, tc.want)
+			}
+
+			if got.Category != CatIdentity {
+				t.Errorf("IdentityMessage() Category = %q, want %q", got.Category, CatIdentity)
+			}
+		})
+	}
+}
@@ -40,3 +40,33 @@ func (Value) SingularMsg(typ types.Type, valueMethod bool, name string) diag.Cat
 func (Value) PluralMsg(typ types.Type, valueMethod bool, names string) diag.CategorizedMessage {
 	return Formatf(CatVar, valueMethod, "variables %s point to zero-sized type %q", names, typ)
 }
+
+// SentinelMessage returns a message for multiple package-level variables initialized with
+// distinct pointers to the same zero-sized type.
+func SentinelMessage(typ types.Type, valueMethod bool, names string) diag.CategorizedMessage {
+	return Formatf(CatSentinel, valueMethod,
+		"variables %s are initialized with pointers to zero-sized type %q and might not be distinct", names, typ)
+}
+
+// ConstructionMessage returns a message for a construction of a zero-sized error type
+// that deviates from the dominant form.
+func ConstructionMessage(typ types.Type, valueMethod, pointer bool) diag.CategorizedMessage {
+	if pointer {
+		return Formatf(CatConstruction, valueMethod,
+			"error type %q is constructed as pointer, but mostly as value elsewhere", typ)
+	}
+
+	return Formatf(CatConstruction, valueMethod,
+		"error type %q is constructed as value, but mostly as pointer elsewhere", typ)
+}
+
+// FlagMessage returns a message for a variable or field of pointer to zero-sized type only used as a boolean flag.
+func FlagMessage(typ types.Type, valueMethod, field bool, name string) diag.CategorizedMessage {
+	kind := "variable"
+	if field {
+		kind = "field"
+	}
+
+	return Formatf(CatFlag, valueMethod,
+		"%s %q is pointer to zero-sized type %q, but only used as boolean flag", kind, name, typ)
+}
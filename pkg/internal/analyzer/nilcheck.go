@@ -0,0 +1,371 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analyzer
+
+import (
+	"cmp"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"slices"
+	"strconv"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ast/edge"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"fillmore-labs.com/zerolint/pkg/internal/diag"
+)
+
+// usesOf returns the identifiers referring to obj in the current package, sorted by position.
+func (v *Visitor) usesOf(obj types.Object) []*ast.Ident {
+	if v.uses == nil {
+		v.uses = make(map[types.Object][]*ast.Ident)
+		for id, o := range v.Diag.TypesInfo().Uses {
+			v.uses[o] = append(v.uses[o], id)
+		}
+
+		for _, ids := range v.uses {
+			slices.SortFunc(ids, func(a, b *ast.Ident) int { return cmp.Compare(a.Pos(), b.Pos()) })
+		}
+	}
+
+	return v.uses[obj]
+}
+
+// nilUseEdits returns edits for a use of a variable changed to the zero-sized type elem, when it is compared
+// with or assigned nil. It returns false when the use needs an edit that can't be made.
+func (v *Visitor) nilUseEdits(id *ast.Ident, elem types.Type, imports diag.Imports) ([]analysis.TextEdit, bool) {
+	c, ok := v.root.FindByPos(id.Pos(), id.End())
+	if !ok || c.Node() != id {
+		return nil, false
+	}
+
+	if k, _ := c.ParentEdge(); k == edge.SelectorExpr_Sel {
+		c = c.Parent() // x.f
+	}
+
+	x, _ := c.Node().(ast.Expr)
+	c = unparen(c)
+
+	switch k, i := c.ParentEdge(); k {
+	case edge.BinaryExpr_X, edge.BinaryExpr_Y:
+		n, _ := c.Parent().Node().(*ast.BinaryExpr)
+		if n.Op != token.EQL && n.Op != token.NEQ || !v.isNil(n.X) && !v.isNil(n.Y) {
+			return nil, true
+		}
+
+		if !v.isPure(x) { // Evaluating x might panic.
+			return nil, false
+		}
+
+		return v.constantCheckEdits(c.Parent(), n.Op == token.NEQ), true
+
+	case edge.AssignStmt_Lhs:
+		n, _ := c.Parent().Node().(*ast.AssignStmt)
+		if n.Tok != token.ASSIGN || len(n.Lhs) != len(n.Rhs) || !v.isNil(n.Rhs[i]) {
+			return nil, true
+		}
+
+		return v.zeroValueEdits(n.Rhs[i], elem, imports)
+
+	case edge.KeyValueExpr_Key: // T{f: nil}
+		n, _ := c.Parent().Node().(*ast.KeyValueExpr)
+		if !v.isNil(n.Value) {
+			return nil, true
+		}
+
+		return v.zeroValueEdits(n.Value, elem, imports)
+
+	case edge.SwitchStmt_Tag: // switch x { case nil: }
+		n, _ := c.Parent().Node().(*ast.SwitchStmt)
+
+		return v.nilCaseEdits(n)
+
+	default:
+		return nil, true
+	}
+}
+
+// keepNilCheckedResults keeps pointer results of the function declaration at c, together with the values
+// returned for them, when callers compare the results with nil.
+// Callers can be in other packages, so this is a best effort for exported functions.
+func (v *Visitor) keepNilCheckedResults(c inspector.Cursor, n *ast.FuncDecl) {
+	fn := v.Diag.TypesInfo().Defs[n.Name]
+	if fn == nil || n.Type.Results == nil {
+		return
+	}
+
+	var (
+		kept  []bool
+		found bool
+	)
+
+	for _, field := range n.Type.Results.List {
+		keep := false
+
+		if elem, _, zeroSized := v.Check.ZeroSizedTypePointer(v.Diag.TypesInfo().TypeOf(field.Type)); zeroSized {
+			keep = slices.ContainsFunc(v.usesOf(fn), func(id *ast.Ident) bool { return v.resultNilChecked(id, elem) })
+		}
+
+		if keep {
+			v.keepAddress(field.Type)
+			found = true
+		}
+
+		for range max(len(field.Names), 1) {
+			kept = append(kept, keep)
+		}
+	}
+
+	if !found || n.Body == nil {
+		return
+	}
+
+	for ret := range AllReturns(c.ChildAt(edge.FuncDecl_Body, -1)) {
+		if len(ret.Results) != len(kept) {
+			continue
+		}
+
+		for i, x := range ret.Results {
+			if kept[i] {
+				v.keepAddress(x)
+			}
+		}
+	}
+}
+
+// resultNilChecked reports whether the result of a call of the function id is compared with nil,
+// directly or through a variable it initializes.
+func (v *Visitor) resultNilChecked(id *ast.Ident, elem types.Type) bool {
+	c, ok := v.root.FindByPos(id.Pos(), id.End())
+	if !ok || c.Node() != id {
+		return false
+	}
+
+	if k, _ := c.ParentEdge(); k == edge.SelectorExpr_Sel {
+		c = c.Parent() // pkg.f or x.m
+	}
+
+	c = unparen(c)
+	if k, _ := c.ParentEdge(); k != edge.CallExpr_Fun {
+		return false
+	}
+
+	c = unparen(c.Parent())
+
+	var names []ast.Expr
+
+	switch k, _ := c.ParentEdge(); k {
+	case edge.BinaryExpr_X, edge.BinaryExpr_Y:
+		n, _ := c.Parent().Node().(*ast.BinaryExpr)
+
+		return (n.Op == token.EQL || n.Op == token.NEQ) && (v.isNil(n.X) || v.isNil(n.Y))
+
+	case edge.AssignStmt_Rhs:
+		n, _ := c.Parent().Node().(*ast.AssignStmt)
+		names = n.Lhs
+
+	case edge.ValueSpec_Values:
+		n, _ := c.Parent().Node().(*ast.ValueSpec)
+		if n.Type != nil {
+			return false
+		}
+
+		for _, name := range n.Names {
+			names = append(names, name)
+		}
+
+	default:
+		return false
+	}
+
+	imports := make(diag.Imports)
+
+	for _, x := range names {
+		name, ok := x.(*ast.Ident)
+		if !ok {
+			continue
+		}
+
+		obj := v.Diag.TypesInfo().Defs[name]
+		if obj == nil {
+			continue // Assigned to an existing variable, whose declaration is reported separately.
+		}
+
+		for _, use := range v.usesOf(obj) {
+			if e, ok := v.nilUseEdits(use, elem, imports); !ok || len(e) > 0 {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// nilCaseEdits removes nil cases from a switch statement with a tag changed to a zero-sized type.
+// It returns false when a removed clause is the target of a fallthrough statement.
+func (v *Visitor) nilCaseEdits(n *ast.SwitchStmt) ([]analysis.TextEdit, bool) {
+	var edits []analysis.TextEdit
+
+	for i, stmt := range n.Body.List {
+		c, ok := stmt.(*ast.CaseClause)
+		if !ok { // should not happen
+			continue
+		}
+
+		for j, x := range c.List {
+			if !v.isNil(x) {
+				continue
+			}
+
+			if len(c.List) == 1 && i > 0 && fallsThrough(n.Body.List[i-1]) {
+				return nil, false
+			}
+
+			edits = append(edits, v.Diag.RemoveCase(c, j))
+		}
+	}
+
+	return edits, true
+}
+
+// fallsThrough reports whether the case clause ends with a fallthrough statement.
+func fallsThrough(stmt ast.Stmt) bool {
+	c, ok := stmt.(*ast.CaseClause)
+	if !ok || len(c.Body) == 0 {
+		return false
+	}
+
+	b, ok := c.Body[len(c.Body)-1].(*ast.BranchStmt)
+
+	return ok && b.Tok == token.FALLTHROUGH
+}
+
+// constantCheckEdits replaces a nil check with its constant result. When the check is the condition
+// of an if statement, the statement is replaced by the branch taken.
+func (v *Visitor) constantCheckEdits(c inspector.Cursor, result bool) []analysis.TextEdit {
+	n := c.Node()
+
+	c = unparen(c)
+	if k, _ := c.ParentEdge(); k == edge.IfStmt_Cond {
+		if s, _ := c.Parent().Node().(*ast.IfStmt); s.Init == nil {
+			return v.Diag.SimplifyIf(s, result, c.Parent().Parent().Node())
+		}
+	}
+
+	return []analysis.TextEdit{{Pos: n.Pos(), End: n.End(), NewText: []byte(strconv.FormatBool(result))}}
+}
+
+// zeroValueEdits replaces a nil value with the zero value of elem.
+func (v *Visitor) zeroValueEdits(n ast.Expr, elem types.Type, imports diag.Imports) ([]analysis.TextEdit, bool) {
+	zero, ok := v.Diag.ZeroValue(n.Pos(), elem, imports)
+	if !ok {
+		return nil, false
+	}
+
+	return []analysis.TextEdit{{Pos: n.Pos(), End: n.End(), NewText: zero}}, true
+}
+
+// removesLastUse reports whether the edits remove all uses of a local variable, label or imported package
+// declared outside the edited text, which would no longer compile.
+func (v *Visitor) removesLastUse(edits []analysis.TextEdit) bool {
+	removed := func(pos token.Pos) bool {
+		return slices.ContainsFunc(edits, func(e analysis.TextEdit) bool { return e.Pos <= pos && pos < e.End })
+	}
+
+	for _, e := range edits {
+		if e.Pos >= e.End {
+			continue
+		}
+
+		c, ok := v.root.FindByPos(e.Pos, e.End)
+		if !ok {
+			continue
+		}
+
+		for c := range c.Preorder((*ast.Ident)(nil)) {
+			id := c.Node().(*ast.Ident)
+			if !removed(id.Pos()) {
+				continue
+			}
+
+			obj := v.Diag.TypesInfo().Uses[id]
+			if !v.mustBeUsed(obj) || removed(obj.Pos()) {
+				continue
+			}
+
+			if !slices.ContainsFunc(v.usesOf(obj), func(id *ast.Ident) bool { return !removed(id.Pos()) }) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// mustBeUsed reports whether obj is a local variable, label or imported package, which must be used.
+func (v *Visitor) mustBeUsed(obj types.Object) bool {
+	switch obj := obj.(type) {
+	case *types.Var:
+		if obj.IsField() || obj.Parent() == nil || obj.Parent() == obj.Pkg().Scope() {
+			return false
+		}
+
+		// Parameters and results need not be used.
+		c, ok := v.root.FindByPos(obj.Pos(), obj.Pos())
+		if !ok {
+			return false
+		}
+
+		k, _ := c.ParentEdge()
+
+		return k != edge.Field_Names
+
+	case *types.Label, *types.PkgName:
+		return true
+
+	default:
+		return false
+	}
+}
+
+// isExported reports whether obj is a package-level variable or field that might be used by other packages.
+func isExported(obj types.Object) bool {
+	v, ok := obj.(*types.Var)
+	if !ok || !v.Exported() {
+		return false
+	}
+
+	return v.IsField() || v.Parent() == v.Pkg().Scope()
+}
+
+// isNil reports whether x is the predeclared nil.
+func (v *Visitor) isNil(x ast.Expr) bool {
+	return v.Diag.TypesInfo().Types[x].IsNil()
+}
+
+// unparen returns the outermost parenthesized expression around the node of c.
+func unparen(c inspector.Cursor) inspector.Cursor {
+	for {
+		if k, _ := c.ParentEdge(); k != edge.ParenExpr_X {
+			return c
+		}
+
+		c = c.Parent()
+	}
+}
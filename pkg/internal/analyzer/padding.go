@@ -0,0 +1,83 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analyzer
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+
+	"fillmore-labs.com/zerolint/pkg/internal/analyzer/msg"
+)
+
+// checkTrailingField reports a zero-sized final field of a struct that is not zero-sized.
+// The compiler pads such structs, so that the address of the final field does not point past the object.
+func (v *Visitor) checkTrailingField(n *ast.StructType) {
+	fields := n.Fields.List
+	if len(fields) == 0 || v.Check.Sizes == nil {
+		return
+	}
+
+	s, ok := v.Diag.TypesInfo().TypeOf(n).(*types.Struct)
+	if !ok || s.NumFields() < 2 {
+		return
+	}
+
+	// Find the trailing zero-sized fields.
+	trailing := s.NumFields()
+	for trailing > 0 {
+		t := s.Field(trailing - 1).Type()
+		if _, ok := t.(*types.TypeParam); ok { // Size depends on the instantiation.
+			break
+		}
+
+		if _, zeroSized := v.Check.ZeroSizedType(t); !zeroSized {
+			break
+		}
+
+		trailing--
+	}
+
+	if trailing == 0 || trailing == s.NumFields() { // Zero-sized struct or no trailing zero-sized fields.
+		return
+	}
+
+	// Calculate the size with the zero-sized fields moved to the front.
+	reordered := make([]*types.Var, 0, s.NumFields())
+	for i := range s.NumFields() {
+		reordered = append(reordered, s.Field((i+trailing)%s.NumFields()))
+	}
+
+	size, frontSize := v.Check.Sizes.Sizeof(s), v.Check.Sizes.Sizeof(types.NewStruct(reordered, nil))
+	if frontSize >= size {
+		return
+	}
+
+	field := fields[len(fields)-1]
+	last := s.Field(s.NumFields() - 1)
+
+	cM := msg.Formatf(msg.CatStructPadding, false,
+		"trailing zero-sized field of type %q pads struct from %d to %d bytes", last.Type(), frontSize, size)
+
+	var fixes []analysis.SuggestedFix
+	if s.NumFields()-trailing <= max(len(field.Names), 1) && !v.hasUnkeyedLiterals(v.declaredType(n)) { // Move only the last field.
+		fixes = v.Diag.MoveToFront(n.Fields)
+	}
+
+	v.Diag.Report(field, cM, fixes)
+}
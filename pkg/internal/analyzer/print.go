@@ -0,0 +1,265 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analyzer
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ast/astutil"
+
+	"fillmore-labs.com/zerolint/pkg/internal/analyzer/msg"
+	"fillmore-labs.com/zerolint/pkg/internal/typeutil"
+)
+
+// stringerType is the [fmt.Stringer] interface.
+var stringerType = types.NewInterfaceType([]*types.Func{ //nolint:gochecknoglobals
+	types.NewFunc(token.NoPos, nil, "String", types.NewSignatureType(nil, nil, nil,
+		nil, types.NewTuple(types.NewParam(token.NoPos, nil, "", types.Typ[types.String])), false)),
+}, nil).Complete()
+
+// errorType is the predeclared error interface.
+var errorType = types.Universe.Lookup("error").Type().Underlying().(*types.Interface) //nolint:gochecknoglobals,forcetypeassert
+
+// visitPrint analyzes the arguments of print-style functions like fmt.Println.
+func (v *Visitor) visitPrint(n *ast.CallExpr, args []ast.Expr, funcName typeutil.FuncName) bool {
+	if n.Ellipsis.IsValid() { // fmt.Print(args...)
+		return true
+	}
+
+	for _, arg := range args {
+		v.checkPrintArg(arg, funcName)
+	}
+
+	return true
+}
+
+// visitPrintf analyzes the arguments of printf-style functions like fmt.Printf,
+// considering only operands of verbs that call String() or Error() methods or format addresses.
+func (v *Visitor) visitPrintf(n *ast.CallExpr, format ast.Expr, args []ast.Expr, funcName typeutil.FuncName) bool {
+	if n.Ellipsis.IsValid() { // fmt.Printf(format, args...)
+		return true
+	}
+
+	tv := v.Diag.TypesInfo().Types[format]
+	if tv.Value == nil || tv.Value.Kind() != constant.String {
+		return true // Non-constant format
+	}
+
+	verbs, ok := formatVerbs(constant.StringVal(tv.Value))
+	if !ok {
+		return true
+	}
+
+	for _, verb := range verbs {
+		if verb.arg >= len(args) {
+			break
+		}
+
+		switch verb.verb {
+		case 'p': // %p formats the address
+			v.checkAddressArg(args[verb.arg], args[verb.arg], funcName)
+
+			continue
+
+		case 'v':
+			if verb.sharp { // %#v uses GoString()
+				continue
+			}
+
+		case 's', 'q', 'x', 'X':
+
+		default:
+			continue
+		}
+
+		v.checkPrintArg(args[verb.arg], funcName)
+	}
+
+	return true
+}
+
+// checkPrintArg reports zero-sized values where only the pointer type implements [fmt.Stringer] or error,
+// so the method is silently not called when printing.
+func (v *Visitor) checkPrintArg(arg ast.Expr, funcName typeutil.FuncName) {
+	t := v.Diag.TypesInfo().TypeOf(arg)
+	if t == nil || types.IsInterface(t) {
+		return
+	}
+
+	if _, ok := t.Underlying().(*types.Pointer); ok {
+		return
+	}
+
+	valueMethod, zeroSized := v.Check.ZeroSizedType(t)
+	if !zeroSized {
+		return
+	}
+
+	method, ok := pointerOnlyMethod(t)
+	if !ok {
+		return
+	}
+
+	cM := msg.Formatf(msg.CatPrint, valueMethod,
+		"method %s of zero-sized type %q has a pointer receiver and is not called by %s", method, t, funcName)
+	fixes := v.valueReceiver(t, method)
+	v.Diag.Report(arg, cM, fixes)
+}
+
+// pointerOnlyMethod checks whether a pointer to t, but not t itself, implements error or [fmt.Stringer]
+// and returns the name of the method fmt would call.
+func pointerOnlyMethod(t types.Type) (string, bool) {
+	if types.Implements(t, errorType) || types.Implements(t, stringerType) {
+		return "", false
+	}
+
+	if obj, _, _ := types.LookupFieldOrMethod(t, false, nil, "Format"); obj != nil {
+		return "", false // Possibly implements fmt.Formatter
+	}
+
+	p := types.NewPointer(t)
+
+	switch {
+	case types.Implements(p, errorType):
+		return "Error", true
+
+	case types.Implements(p, stringerType):
+		return "String", true
+
+	default:
+		return "", false
+	}
+}
+
+// valueReceiver suggests a fix that changes the pointer receiver of method on t to a value receiver,
+// if the method is declared in the current package. Nil checks of the receiver are rewritten like in
+// [Visitor.visitFuncRecv].
+func (v *Visitor) valueReceiver(t types.Type, method string) []analysis.SuggestedFix {
+	obj, _, _ := types.LookupFieldOrMethod(types.NewPointer(t), false, nil, method)
+
+	fun, ok := obj.(*types.Func)
+	if !ok {
+		return nil
+	}
+
+	f := v.Diag.File(fun.Pos())
+	if f == nil { // Declared in another package.
+		return nil
+	}
+
+	path, _ := astutil.PathEnclosingInterval(f, fun.Pos(), fun.Pos())
+	for _, n := range path {
+		if decl, ok := n.(*ast.FuncDecl); ok && decl.Recv != nil && len(decl.Recv.List) == 1 {
+			recv := decl.Recv.List[0]
+
+			return v.removeStarOf(recv.Type, recv.Names, nil, t)
+		}
+	}
+
+	return nil
+}
+
+// formatVerb is a verb of a printf format string with the index of its operand.
+type formatVerb struct {
+	verb  rune
+	arg   int
+	sharp bool
+}
+
+// formatVerbs parses a printf format string and returns its verbs with the index of their operands.
+// It returns false for format strings using explicit argument indexes.
+func formatVerbs(format string) ([]formatVerb, bool) {
+	var (
+		verbs []formatVerb
+		arg   int
+	)
+
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			continue
+		}
+
+		i++
+
+		var sharp bool
+
+		// Flags
+		for ; i < len(format) && strings.IndexByte("+-# 0", format[i]) >= 0; i++ {
+			if format[i] == '#' {
+				sharp = true
+			}
+		}
+
+		// Width and precision
+		var ok bool
+		if i, arg, ok = skipNum(format, i, arg); !ok {
+			return nil, false
+		}
+
+		if i < len(format) && format[i] == '.' {
+			if i, arg, ok = skipNum(format, i+1, arg); !ok {
+				return nil, false
+			}
+		}
+
+		if i >= len(format) {
+			break
+		}
+
+		if format[i] == '[' {
+			return nil, false // Explicit argument index
+		}
+
+		verb, size := utf8.DecodeRuneInString(format[i:])
+		i += size - 1
+
+		if verb == '%' {
+			continue
+		}
+
+		verbs = append(verbs, formatVerb{verb: verb, arg: arg, sharp: sharp})
+		arg++
+	}
+
+	return verbs, true
+}
+
+// skipNum skips a width or precision, which is either a number or '*', consuming an operand.
+// It returns false for an explicit argument index.
+func skipNum(format string, i, arg int) (int, int, bool) {
+	if i < len(format) {
+		switch format[i] {
+		case '[':
+			return i, arg, false
+
+		case '*':
+			return i + 1, arg + 1, true
+		}
+	}
+
+	for i < len(format) && '0' <= format[i] && format[i] <= '9' {
+		i++
+	}
+
+	return i, arg, true
+}
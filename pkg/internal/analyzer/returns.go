@@ -21,6 +21,7 @@ import (
 	"go/types"
 	"iter"
 
+	"golang.org/x/tools/go/analysis"
 	"golang.org/x/tools/go/ast/inspector"
 
 	"fillmore-labs.com/zerolint/pkg/internal/analyzer/msg"
@@ -109,7 +110,12 @@ func (v *Visitor) visitReturnStmt(n *ast.ReturnStmt, returnTypes []retType) {
 		if tv.IsNil() {
 			cM := msg.Formatf(msg.CatReturnNil, returnType.valueMethod,
 				"explicitly returning nil for pointer to zero-sized type %q", returnType.elem)
-			fixes := v.Diag.ReplaceWithZeroValue(result, returnType.elem)
+
+			var fixes []analysis.SuggestedFix
+			if !v.pointerKept(ast.Unparen(result)) { // Callers compare the result with nil.
+				fixes = v.Diag.ReplaceWithZeroValue(result, returnType.elem)
+			}
+
 			v.Diag.Report(result, cM, fixes)
 		}
 	}
@@ -0,0 +1,214 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analyzer
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ast/inspector"
+	"golang.org/x/tools/go/types/typeutil"
+
+	"fillmore-labs.com/zerolint/pkg/internal/analyzer/msg"
+)
+
+// sentinelGroup collects package-level variables initialized with pointers to the same zero-sized type.
+type sentinelGroup struct {
+	typ         types.Type
+	valueMethod bool
+	names       []*ast.Ident
+	values      []ast.Expr
+}
+
+// checkSentinels groups package-level variables initialized with `&T{}` or `new(T)` by their zero-sized type T
+// and reports groups with more than one member, since these variables might not be distinct.
+func (v *Visitor) checkSentinels(root inspector.Cursor) {
+	var (
+		groups typeutil.Map // types.Type -> *sentinelGroup
+		order  []*sentinelGroup
+	)
+
+	for c := range root.Children() {
+		f, ok := c.Node().(*ast.File)
+		if !ok || !v.Generated && ast.IsGenerated(f) {
+			continue
+		}
+
+		for _, decl := range f.Decls {
+			d, ok := decl.(*ast.GenDecl)
+			if !ok || d.Tok != token.VAR {
+				continue
+			}
+
+			for _, spec := range d.Specs {
+				s, ok := spec.(*ast.ValueSpec)
+				if !ok || len(s.Names) != len(s.Values) {
+					continue
+				}
+
+				for i, name := range s.Names {
+					if name.Name == "_" {
+						continue
+					}
+
+					t, ok := v.sentinelType(s.Values[i])
+					if !ok {
+						continue
+					}
+
+					valueMethod, zeroSized := v.Check.ZeroSizedType(t)
+					if !zeroSized {
+						continue
+					}
+
+					g, _ := groups.At(t).(*sentinelGroup)
+					if g == nil {
+						g = &sentinelGroup{typ: t, valueMethod: valueMethod}
+						groups.Set(t, g)
+						order = append(order, g)
+					}
+
+					g.names = append(g.names, name)
+					g.values = append(g.values, s.Values[i])
+				}
+			}
+		}
+	}
+
+	for _, g := range order {
+		if len(g.names) < 2 {
+			continue
+		}
+
+		v.reportSentinels(g)
+	}
+}
+
+// reportSentinels reports a group of variables at the first declaration, citing each declaration.
+func (v *Visitor) reportSentinels(g *sentinelGroup) {
+	quoted := make([]string, len(g.names))
+	related := make([]analysis.RelatedInformation, len(g.names))
+
+	for i, name := range g.names {
+		quoted[i] = strconv.Quote(name.Name)
+		related[i] = analysis.RelatedInformation{
+			Pos:     name.Pos(),
+			End:     name.End(),
+			Message: quoted[i] + " declared here",
+		}
+	}
+
+	cM := msg.SentinelMessage(g.typ, g.valueMethod, strings.Join(quoted, ", "))
+	v.Diag.ReportRelated(g.names[0], cM, related, v.sentinelAlternatives(g))
+}
+
+// sentinelAlternatives returns a fix replacing the values of a group of error variables with errors.New,
+// using the constant message of the Error method.
+// The fix is only offered when all variables are declared with an interface type error values can be assigned to.
+func (v *Visitor) sentinelAlternatives(g *sentinelGroup) []analysis.SuggestedFix {
+	if !v.Alternatives {
+		return nil
+	}
+
+	for _, name := range g.names {
+		obj := v.Diag.TypesInfo().Defs[name]
+		if obj == nil || !types.IsInterface(obj.Type()) || !types.AssignableTo(errorType, obj.Type()) {
+			return nil
+		}
+	}
+
+	text, ok := v.errorText(g.typ)
+	if !ok {
+		return nil
+	}
+
+	return v.Diag.NewErrors(g.values, text)
+}
+
+// errorText returns the message of the Error method of t, when it returns a constant string.
+func (v *Visitor) errorText(t types.Type) (string, bool) {
+	obj, _, _ := types.LookupFieldOrMethod(types.NewPointer(t), false, v.Diag.Pkg(), "Error")
+
+	fn, ok := obj.(*types.Func)
+	if !ok || fn.Pkg() != v.Diag.Pkg() {
+		return "", false
+	}
+
+	c, ok := v.root.FindByPos(fn.Pos(), fn.Pos())
+	if !ok {
+		return "", false
+	}
+
+	decl, ok := c.Parent().Node().(*ast.FuncDecl)
+	if !ok || decl.Body == nil || len(decl.Body.List) != 1 {
+		return "", false
+	}
+
+	ret, ok := decl.Body.List[0].(*ast.ReturnStmt)
+	if !ok || len(ret.Results) != 1 {
+		return "", false
+	}
+
+	value := v.Diag.TypesInfo().Types[ret.Results[0]].Value
+	if value == nil || value.Kind() != constant.String {
+		return "", false
+	}
+
+	return constant.StringVal(value), true
+}
+
+// sentinelType returns the type T of an `&T{}` or `new(T)` expression.
+func (v *Visitor) sentinelType(x ast.Expr) (types.Type, bool) {
+	switch e := ast.Unparen(x).(type) {
+	case *ast.UnaryExpr: // &T{}
+		if e.Op != token.AND {
+			break
+		}
+
+		if _, ok := ast.Unparen(e.X).(*ast.CompositeLit); !ok {
+			break
+		}
+
+		if t := v.Diag.TypesInfo().TypeOf(e.X); t != nil {
+			return t, true
+		}
+
+	case *ast.CallExpr: // new(T)
+		if len(e.Args) != 1 {
+			break
+		}
+
+		if fun, ok := ast.Unparen(e.Fun).(*ast.Ident); !ok || fun.Name != "new" {
+			break
+		}
+
+		if tv, ok := v.Diag.TypesInfo().Types[e.Fun]; !ok || !tv.IsBuiltin() {
+			break
+		}
+
+		if t := v.Diag.TypesInfo().TypeOf(e.Args[0]); t != nil {
+			return t, true
+		}
+	}
+
+	return nil, false
+}
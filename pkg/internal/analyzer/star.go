@@ -18,8 +18,13 @@ package analyzer
 
 import (
 	"go/ast"
+	"go/token"
+	"go/types"
 
 	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ast/edge"
+
+	"fillmore-labs.com/zerolint/pkg/internal/diag"
 )
 
 // removeStar suggests a fix that removes the star ('*') operator from an expression, if possible.
@@ -34,6 +39,124 @@ func (v *Visitor) removeStar(x ast.Expr) []analysis.SuggestedFix {
 	return nil
 }
 
+// removeStarOf suggests a fix that removes the star ('*') operator from the type of the declared names.
+// Nil checks and nil assignments of the names, as well as nil values of a declaration, no longer compile after
+// the fix, so companion edits replace them with their constant result or the zero value of elem. No fix is
+// suggested when a companion edit can't be made.
+//
+// Only uses in the current package can be edited, so no fix is suggested for exported variables and fields
+// that need companion edits, since other packages probably use them the same way.
+func (v *Visitor) removeStarOf(x ast.Expr, names []*ast.Ident, values []ast.Expr, elem types.Type,
+) []analysis.SuggestedFix {
+	fixes := v.removeStar(x)
+	if len(fixes) == 0 {
+		return nil
+	}
+
+	return v.addNilUseEdits(fixes, names, values, elem)
+}
+
+// removeAddress suggests fixes for the allocation n of a zero-sized value of type elem.
+// When n initializes a variable declared without type, the variable changes its type with the fix,
+// so nil checks and nil assignments of the variable are rewritten like with [Visitor.removeStarOf].
+func (v *Visitor) removeAddress(n ast.Expr, fixes []analysis.SuggestedFix, elem types.Type,
+) []analysis.SuggestedFix {
+	name, ok := v.initializedVar(n)
+	if !ok {
+		return fixes
+	}
+
+	return v.addNilUseEdits(fixes, []*ast.Ident{name}, nil, elem)
+}
+
+// initializedVar returns the name of the variable initialized by x in `name := x` or `var name = x`.
+func (v *Visitor) initializedVar(x ast.Expr) (*ast.Ident, bool) {
+	c, ok := v.root.FindByPos(x.Pos(), x.End())
+	if !ok {
+		return nil, false
+	}
+
+	c = unparen(c)
+
+	switch k, i := c.ParentEdge(); k {
+	case edge.AssignStmt_Rhs:
+		a, _ := c.Parent().Node().(*ast.AssignStmt)
+		if a.Tok != token.DEFINE || len(a.Lhs) != len(a.Rhs) {
+			return nil, false
+		}
+
+		name, ok := a.Lhs[i].(*ast.Ident)
+
+		return name, ok && v.Diag.TypesInfo().Defs[name] != nil
+
+	case edge.ValueSpec_Values:
+		s, _ := c.Parent().Node().(*ast.ValueSpec)
+		if s.Type != nil || len(s.Names) != len(s.Values) {
+			return nil, false
+		}
+
+		return s.Names[i], true
+
+	default:
+		return nil, false
+	}
+}
+
+// addNilUseEdits adds companion edits for nil checks and nil assignments of names, as well as nil values,
+// to fixes changing their type from pointer to elem.
+func (v *Visitor) addNilUseEdits(fixes []analysis.SuggestedFix, names []*ast.Ident, values []ast.Expr,
+	elem types.Type,
+) []analysis.SuggestedFix {
+	var (
+		edits   []analysis.TextEdit
+		imports = make(diag.Imports)
+	)
+
+	for _, name := range names {
+		obj := v.Diag.TypesInfo().Defs[name]
+		if obj == nil {
+			continue
+		}
+
+		for _, id := range v.usesOf(obj) {
+			e, ok := v.nilUseEdits(id, elem, imports)
+			if !ok || len(e) > 0 && isExported(obj) {
+				return nil
+			}
+
+			edits = append(edits, e...)
+		}
+	}
+
+	for _, value := range values { // var x *T = nil
+		if !v.isNil(value) {
+			continue
+		}
+
+		e, ok := v.zeroValueEdits(value, elem, imports)
+		if !ok {
+			return nil
+		}
+
+		edits = append(edits, e...)
+	}
+
+	if len(edits) == 0 {
+		return fixes
+	}
+
+	if v.removesLastUse(edits) {
+		return nil
+	}
+
+	importEdits, ok := v.Diag.ImportEdits(imports)
+	if !ok {
+		return nil
+	}
+
+	return diag.AddEdits(fixes, append(edits, importEdits...))
+}
+
 // ignoreStar ignores the star expression in further processing.
 func (v *Visitor) ignoreStar(n *ast.StarExpr) {
 	v.seenStars.Add(n.Pos())
@@ -0,0 +1,67 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import "errors"
+
+type marker struct{}
+
+type options struct {
+	name   string
+	marker *marker // want " \\(zl:fld\\)$"
+}
+
+type config struct {
+	opts options
+	_    *marker // want " \\(zl:fld\\)$"
+}
+
+type markers [2]*marker // want " \\(zl:typ\\)$"
+
+type noMarkers [0]*marker // want " \\(zl:typ\\)$"
+
+type blank struct {
+	_    *marker // want " \\(zl:fld\\)$"
+	name string
+}
+
+type configError struct {
+	marker *marker // want " \\(zl:fld\\)$"
+}
+
+func (configError) Error() string { return "config error" }
+
+func CompareComposite(cfg, other config, m, n markers, z, y noMarkers, b, c blank, err error) bool {
+	switch {
+	case cfg == other: // want "\"cfg.opts.marker\".* \\(zl:cmc\\)$"
+		return true
+
+	case cfg.opts != other.opts: // want "\"cfg.opts.marker\".* \\(zl:cmc\\)$"
+		return true
+
+	case m == n: // want "\"m\\[i\\]\".* \\(zl:cmc\\)$"
+		return true
+
+	case z == y, b == c: // want " \\(zl:cmv\\)$"
+		return true
+
+	case errors.Is(err, configError{}): // want "\"configError{}.marker\".* \\(zl:cmc\\)$"
+		return true
+	}
+
+	return any(cfg) == any(other)
+}
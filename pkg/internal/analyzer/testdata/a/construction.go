@@ -0,0 +1,44 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import "test/a/b"
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string { return "timeout" }
+
+func Timeout(deadline bool) error {
+	switch {
+	case deadline:
+		return timeoutError{}
+
+	case !deadline:
+		return &timeoutError{} // want " \\(zl:mix\\+\\)$" " \\(zl:add\\+\\)$"
+
+	default:
+		return (timeoutError{})
+	}
+}
+
+func NotFound() any {
+	return b.NotFoundError{} // want " \\(zl:mix\\)$"
+}
+
+func NotFoundPointer() any {
+	return &b.NotFoundError{} // want " \\(zl:add\\)$"
+}
@@ -0,0 +1,51 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import "context"
+
+type ctxKey struct{}
+
+type ctxKeyValue struct{}
+
+func (ctxKeyValue) String() string { return "value key" }
+
+func WithKey(ctx context.Context, v string) context.Context {
+	return context.WithValue(ctx, &ctxKey{}, v) // want " \\(zl:ctx\\)$" " \\(zl:add\\)$"
+}
+
+func Key(ctx context.Context) (string, bool) {
+	v, ok := ctx.Value(&ctxKey{}).(string) // want " \\(zl:ctx\\)$" " \\(zl:add\\)$"
+
+	return v, ok
+}
+
+var valueKey = &ctxKeyValue{} // want " \\(zl:add\\+\\)$"
+
+func WithValueKey(ctx context.Context, v string) context.Context {
+	return context.WithValue(ctx, valueKey, v) // want " \\(zl:ctx\\+\\)$"
+}
+
+func ValueKey(ctx context.Context) any {
+	return context.Context.Value(ctx, (&ctxKeyValue{})) // want " \\(zl:ctx\\+\\)$" " \\(zl:add\\+\\)$"
+}
+
+func ValidKeys(ctx context.Context) context.Context {
+	_ = ctx.Value(ctxKey{})
+
+	return context.WithValue(ctx, ctxKeyValue{}, nil)
+}
@@ -0,0 +1,37 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+type (
+	deep0  struct{}
+	deep1  struct{ _ deep0 }
+	deep2  struct{ _ deep1 }
+	deep3  struct{ _ deep2 }
+	deep4  struct{ _ deep3 }
+	deep5  struct{ _ deep4 }
+	deep6  struct{ _ deep5 }
+	deep7  struct{ _ deep6 }
+	deep8  struct{ _ deep7 }
+	deep9  struct{ _ deep8 }
+	deep10 struct{ _ deep9 }
+	deep11 struct{ _ [1]deep10 }
+	deep12 struct{ _ deep11 }
+)
+
+func Deep(a, b deep12) bool {
+	return &a == &b // want "^comparison of pointers to zero-size type \"test/a.deep12\" \\(zl:cmp\\)$" " \\(zl:add\\)$" " \\(zl:add\\)$"
+}
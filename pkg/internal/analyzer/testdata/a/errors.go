@@ -37,8 +37,8 @@ func IgnoreErrors() {
 		fmt.Println("nil")
 	}
 
-	var oneErr *typedError[int] // want " \\(zl:var\\)$"
-	if errors.As(ErrOne, &oneErr) {
+	var oneErr *typedError[int]     // want " \\(zl:var\\)$"
+	if errors.As(ErrOne, &oneErr) { // want " \\(zl:tgt\\)$"
 		fmt.Println("ErrOne is typedError[int]")
 	}
 
@@ -69,7 +69,7 @@ func IgnoreErrors() {
 		fmt.Println("equal")
 	}
 
-	a := typedError[int]{}
+	a := typedError[int]{}                                                   // want " \\(zl:mix\\)$"
 	current := func(_, _ int) *typedError[int] { return &typedError[int]{} } // want " \\(zl:res\\)$" " \\(zl:add\\)$"
 	old := func(_ int) *typedError[int] { return &typedError[int]{} }        // want " \\(zl:res\\)$" " \\(zl:add\\)$"
 	new := func(_ int) *typedError[int] { return &typedError[int]{} }        // want " \\(zl:res\\)$" " \\(zl:add\\)$"
@@ -78,8 +78,8 @@ func IgnoreErrors() {
 	_ = errors.Is(ErrOne, (old)(0))                                          // want " \\(zl:cmp\\)$"
 	_ = errors.Is(ErrOne, (new)(0))                                          // want " \\(zl:cmp\\)$"
 
-	var err *typedError[int] // want " \\(zl:var\\)$"
-	_ = errors.As(ErrOne, &err)
+	var err *typedError[int]    // want " \\(zl:var\\)$"
+	_ = errors.As(ErrOne, &err) // want " \\(zl:tgt\\)$"
 
 	_ = errors.Join(ErrOne, ErrTwo)
 
@@ -19,3 +19,11 @@ package a
 import "test/a/b"
 
 func Fix1[T any](*b.Empty[T]) {} // want " \\(zl:par\\)$"
+
+func Fix1Comments() {
+	Fix1(&b.Empty[int]{ // want " \\(zl:add\\)$"
+		// no fields
+	})
+
+	Fix1(new(b.Empty[int])) // want " \\(zl:new\\)$"
+}
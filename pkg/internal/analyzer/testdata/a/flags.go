@@ -0,0 +1,76 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not useFlag this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+type flagValue struct{}
+
+type flagConfig struct {
+	verbose *flagValue // want "^field \"verbose\" is pointer to zero-sized type \"test/a.flagValue\", but only used as boolean flag \\(zl:flg\\)$"
+	debug   *flagValue // want " \\(zl:fld\\)$"
+	tagged  *flagValue `json:"tagged"` // want " \\(zl:fld\\)$"
+	Public  *flagValue // want " \\(zl:fld\\)$"
+}
+
+var flagEnabled *flagValue // want "^variable \"flagEnabled\" is pointer to zero-sized type \"test/a.flagValue\", but only used as boolean flag \\(zl:flg\\)$"
+
+func configureFlags(c *flagConfig, on bool) bool {
+	if on {
+		c.verbose = &flagValue{}
+		flagEnabled = new(flagValue)
+	} else {
+		c.verbose = nil
+		flagEnabled = nil
+	}
+
+	c.debug = &flagValue{} // want " \\(zl:add\\)$"
+	useFlag(c.debug)
+
+	return c.verbose != nil && nil == flagEnabled
+}
+
+func newFlagConfig() flagConfig {
+	return flagConfig{verbose: &flagValue{}}
+}
+
+func localFlags() bool {
+	seen := &flagValue{} // want "^variable \"seen\" is pointer to zero-sized type \"test/a.flagValue\", but only used as boolean flag \\(zl:flg\\)$"
+
+	if seen == nil {
+		seen = &flagValue{}
+	}
+
+	var done *flagValue // want " \\(zl:flg\\)$"
+
+	done = nil
+
+	leaked := &flagValue{} // want " \\(zl:add\\)$"
+	useFlag(leaked)
+
+	return seen != nil || done == nil || leaked != nil
+}
+
+type elidedFlags struct {
+	quiet *flagValue // want " \\(zl:fld\\)$"
+}
+
+var _ = []*elidedFlags{{&flagValue{}}} // want " \\(zl:add\\)$"
+
+func (e elidedFlags) isQuiet() bool {
+	return e.quiet != nil
+}
+
+func useFlag(*flagValue) {} // want " \\(zl:par\\)$"
@@ -0,0 +1,49 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import (
+	"runtime"
+	"unique"
+	"weak"
+)
+
+type resource struct{}
+
+type handle struct{ _ int }
+
+func Identity() {
+	r := &resource{} // want " \\(zl:add\\)$"
+
+	runtime.SetFinalizer(r, func(*resource) {}) // want " \\(zl:idn\\)$" " \\(zl:par\\)$"
+
+	runtime.AddCleanup(r, func(int) {}, 0) // want " \\(zl:idn\\)$"
+
+	_ = weak.Make(r) // want " \\(zl:idn\\)$"
+
+	_ = unique.Make(r) // want " \\(zl:idn\\)$"
+
+	h := &handle{}
+
+	runtime.SetFinalizer(h, func(*handle) {})
+
+	_ = weak.Make(h)
+
+	_ = unique.Make(resource{})
+
+	_ = weak.Make(new(resource)) // want " \\(zl:idn\\)$" " \\(zl:new\\)$"
+}
@@ -0,0 +1,72 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import "sync"
+
+type session struct{}
+
+type sessions map[*session]int // want " \\(zl:key\\)$"
+
+func Count(s sessions, p *session) int { // want " \\(zl:par\\)$"
+	return s[p] + s[&session{}] // want " \\(zl:key\\)$" " \\(zl:key\\)$" " \\(zl:add\\)$"
+}
+
+func Forget(s sessions, p *session) { // want " \\(zl:par\\)$"
+	delete(s, p)
+}
+
+var _ = sessions{&session{}: 1} // want " \\(zl:add\\)$"
+
+func Active(m *sync.Map) bool {
+	_, loaded := m.LoadOrStore(&session{}, true) // want " \\(zl:key\\)$" " \\(zl:add\\)$"
+
+	return loaded
+}
+
+func Generic[K comparable](m map[K]int, k K) int {
+	return m[k]
+}
+
+var _ = map[*session]int{} // want " \\(zl:key\\)$"
+
+type singleton struct{}
+
+type registry map[singleton]string // want "^map key is zero-sized type \"test/a.singleton\", so the map holds at most one entry \\(zl:mzk\\)$"
+
+func Registry() registry {
+	const size = 4
+
+	_ = make(registry, size)
+
+	single := make(registry)
+	single[singleton{}] = "only"
+
+	double := make(registry) // want "^map with zero-sized key type \"test/a.singleton\" has 2 insertions, but holds at most one entry \\(zl:mzk\\)$"
+	double[singleton{}] = "first"
+	double[singleton{}] = "second"
+	_ = double[singleton{}]
+
+	return registry{
+		singleton{}: "first",
+		singleton{}: "second", // want "^map literal with zero-sized key type \"test/a.singleton\" has 2 entries, but holds at most one \\(zl:mzk\\)$"
+	}
+}
+
+var _ = map[struct{}]int{} // want " \\(zl:mzk\\)$"
+
+var _ = map[[0]int]bool{{}: true} // want " \\(zl:mzk\\)$"
@@ -0,0 +1,138 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import "test/a/b"
+
+type nilToken struct{}
+
+func useToken(*nilToken) {} // want " \\(zl:par\\)$"
+
+var currentToken *nilToken // want " \\(zl:var\\)$"
+
+func resetToken() {
+	currentToken = nil
+}
+
+func hasToken() bool {
+	useToken(currentToken)
+
+	return currentToken != nil
+}
+
+func checkToken() string {
+	if currentToken == nil {
+		return "none"
+	}
+
+	return "token"
+}
+
+// ExportedToken might be compared with nil in other packages.
+var ExportedToken *nilToken // want " \\(zl:var\\)$"
+
+func hasExportedToken() bool {
+	return ExportedToken != nil
+}
+
+func describeToken(t *nilToken, verbose bool) string { // want " \\(zl:par\\)$"
+	if t != nil {
+		msg := "token"
+
+		return msg
+	} else if verbose {
+		return "verbose"
+	}
+
+	return "none"
+}
+
+func tokenState(ok bool, t *nilToken) int { // want " \\(zl:par\\)$"
+	if ok {
+		return 1
+	} else if t == nil {
+		return 2
+	}
+
+	return 3
+}
+
+func tokenCase(n int, t *nilToken) int { // want " \\(zl:par\\)$"
+	switch n {
+	case 1:
+		if t != nil {
+			n++
+		} else {
+			n--
+		}
+	}
+
+	return n
+}
+
+type tokenHolder struct {
+	tok *nilToken // want " \\(zl:fld\\)$"
+}
+
+func newTokenHolder() tokenHolder {
+	return tokenHolder{tok: nil}
+}
+
+func (h *tokenHolder) clear() {
+	h.tok = nil
+}
+
+func (h tokenHolder) has() bool {
+	useToken(h.tok)
+
+	return (h.tok) != nil
+}
+
+type pointerHolder struct {
+	tok *nilToken // want " \\(zl:fld\\)$"
+}
+
+func (h *pointerHolder) has() bool {
+	_ = h.tok
+
+	return h.tok != nil // h might be nil.
+}
+
+func findToken(ok bool) (t *nilToken) { // want " \\(zl:res\\)$"
+	unset := !ok
+	if t == nil {
+		ok = unset // Last use of unset.
+	}
+
+	_ = ok
+
+	return
+}
+
+func initToken() {
+	var t *nilToken = nil // want " \\(zl:var\\)$"
+
+	useToken(t)
+}
+
+type remoteHolder struct {
+	e *b.Empty[int] // want " \\(zl:fld\\)$"
+}
+
+func (h remoteHolder) use() *b.Empty[int] { // want " \\(zl:res\\)$"
+	return h.e
+}
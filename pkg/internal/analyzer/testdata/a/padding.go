@@ -0,0 +1,76 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import "structs"
+
+type padded struct {
+	count int64
+	_     [0]func() // want "^trailing zero-sized field of type \"\\[0\\]func\\(\\)\" pads struct from 8 to 16 bytes \\(zl:pad\\)$"
+}
+
+type paddedLayout struct {
+	// Documented.
+	x                  int32 // x coordinate.
+	structs.HostLayout       // want " \\(zl:pad\\)$"
+}
+
+type paddedCommented struct {
+	x int32
+	// Marker.
+	_ struct{} // want " \\(zl:pad\\)$"
+}
+
+type paddedNames struct {
+	x    int32
+	a, b struct{} // want " \\(zl:pad\\)$"
+}
+
+type front struct {
+	_     [0]func()
+	count int64
+}
+
+type allZero struct {
+	_ struct{}
+	_ [0]int
+}
+
+type generic[T any] struct {
+	x int32
+	_ T
+}
+
+var _ = struct {
+	n int
+	_ struct{} // want " \\(zl:pad\\)$"
+}{n: 1}
+
+type paddedUnkeyed struct {
+	x int32
+	_ struct{} // want " \\(zl:pad\\)$"
+}
+
+var _ = paddedUnkeyed{1, struct{}{}}
+
+//zerolint:exclude
+type paddingMarker struct{}
+
+type paddedExcluded struct {
+	x int32
+	_ paddingMarker
+}
@@ -23,14 +23,14 @@ import (
 )
 
 func PkgErrors() {
-	var oneErr *typedError[int] // want " \\(zl:var\\)$"
-	if errors.As(ErrOne, &oneErr) {
+	var oneErr *typedError[int]     // want " \\(zl:var\\)$"
+	if errors.As(ErrOne, &oneErr) { // want " \\(zl:tgt\\)$"
 		fmt.Println("ErrOne is typedError[int]")
 	}
 
 	_ = errors.Is(ErrOne, error(ErrTwo))                 // want " \\(zl:cme\\)$"
 	_ = errors.Is(error(ErrTwo), &typedError[float64]{}) // want " \\(zl:add\\)$" " \\(zl:cme\\)$"
 
-	a := typedError[int]{}
+	a := typedError[int]{}    // want " \\(zl:mix\\)$"
 	_ = errors.Is(ErrOne, &a) // want " \\(zl:add\\)$" " \\(zl:cmp\\)$"
 }
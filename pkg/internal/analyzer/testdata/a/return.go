@@ -32,3 +32,5 @@ func ReturnsNil3() (*c.Empty[int], *xError) { // want " \\(zl:res\\)$" " \\(zl:r
 		return (nil), nil // want " \\(zl:ret\\)$" " \\(zl:ret\\+\\)$"
 	}()
 }
+
+func AcceptsEmpty(*c.Empty[int]) {} // want " \\(zl:par\\)$"
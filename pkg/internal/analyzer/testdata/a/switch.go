@@ -0,0 +1,70 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+type notFoundError struct{}
+
+func (*notFoundError) Error() string { return "not found" } // want " \\(zl:err\\)$"
+
+type token struct{}
+
+func Switch(err error, p, q *token) int { // want " \\(zl:par\\)$"
+	switch err {
+	case nil:
+		return 0
+
+	case &notFoundError{}: // want " \\(zl:cme\\)$" " \\(zl:add\\)$"
+		return 1
+	}
+
+	switch p {
+	case q: // want " \\(zl:cmp\\)$"
+		return 2
+
+	case &token{}: // want " \\(zl:cmp\\)$" " \\(zl:add\\)$"
+		return 3
+
+	case nil:
+		return 4
+	}
+
+	switch {
+	case p == q: // want " \\(zl:cmp\\)$"
+		return 5
+	}
+
+	switch x := any(p); x {
+	case q: // want " \\(zl:cmi\\)$"
+		return 6
+	}
+
+	return -1
+}
+
+func switchFallthrough(p *token, n int) int { // want " \\(zl:par\\)$"
+	switch p {
+	case p: // want " \\(zl:cmp\\)$"
+		n++
+
+		fallthrough
+
+	case nil:
+		n++
+	}
+
+	return n
+}
@@ -34,8 +34,8 @@ func TestTestify(t *testing.T) {
 	assert.ErrorIs(t, ErrOne, error(ErrTwo))                 // want " \\(zl:cme\\)$"
 	(*assert.Assertions).ErrorIs(nil, ErrOne, error(ErrTwo)) // want " \\(zl:cme\\)$"
 
-	var oneErr *typedError[int] // want " \\(zl:var\\)$"
-	if assert.ErrorAs(t, ErrOne, &oneErr) {
+	var oneErr *typedError[int]             // want " \\(zl:var\\)$"
+	if assert.ErrorAs(t, ErrOne, &oneErr) { // want " \\(zl:tgt\\)$"
 		fmt.Println("ErrOne is typedError[int]")
 	}
 
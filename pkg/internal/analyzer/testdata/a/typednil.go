@@ -0,0 +1,112 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import "fmt"
+
+type missingError struct{}
+
+func (*missingError) Error() string { return "missing" } // want " \\(zl:err\\)$"
+
+type sizedError struct{ code int }
+
+func (*sizedError) Error() string { return "sized" }
+
+func lookup(ok bool) *missingError { // want " \\(zl:res\\)$"
+	if ok {
+		return nil // want " \\(zl:ret\\)$"
+	}
+
+	return &missingError{} // want " \\(zl:add\\)$"
+}
+
+func validate(ok bool) error {
+	var err *missingError // want " \\(zl:var\\)$"
+	if !ok {
+		err = &missingError{} // want " \\(zl:add\\)$"
+	}
+
+	return err
+}
+
+func check(ok bool) error {
+	if ok {
+		return nil
+	}
+
+	return &missingError{} // want " \\(zl:add\\)$"
+}
+
+func lookupSized(ok bool) *sizedError {
+	if ok {
+		return nil
+	}
+
+	return &sizedError{}
+}
+
+func TypedNil(ok bool) {
+	var err error = lookup(ok)
+	if err != nil { // want "^comparison with nil does not detect nil pointer to zero-sized type \"test/a.missingError\" converted to interface \\(zl:tnl\\)$"
+		fmt.Println(err)
+	}
+
+	if err := validate(ok); err != nil { // want " \\(zl:tnl\\)$"
+		fmt.Println(err)
+	}
+
+	var maybe error
+	if ok {
+		maybe = lookup(ok)
+	}
+
+	if nil == maybe { // want " \\(zl:tnl\\)$"
+		fmt.Println("nil")
+	}
+
+	var a any = err
+	if a == nil { // want " \\(zl:tnl\\)$"
+		fmt.Println("nil")
+	}
+}
+
+func NotTypedNil(ok bool) {
+	var err error = &missingError{} // want " \\(zl:add\\)$"
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	if err := check(ok); err != nil {
+		fmt.Println(err)
+	}
+
+	if err := lookup(ok); err != nil {
+		fmt.Println(err)
+	}
+
+	var sized error = lookupSized(ok)
+	if sized != nil {
+		fmt.Println(sized)
+	}
+}
+
+func NotNilOrigin(p *missingError) { // want " \\(zl:par\\)$"
+	var err error = p
+	if err != nil {
+		fmt.Println(err)
+	}
+}
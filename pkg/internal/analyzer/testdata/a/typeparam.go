@@ -25,3 +25,17 @@ func TypeParam() {
 
 	_ = &embedded[empty]{} // want " \\(zl:add\\)$"
 }
+
+type zeroConstraint interface{ struct{} | [0]int }
+
+func sameZero[T zeroConstraint](p, q *T) bool { // want "^function parameters \"p\", \"q\" point to zero-sized type \"T\" \\(zl:par\\)$"
+	return p == q // want "^comparison of pointers to zero-size type \"T\" \\(zl:cmp\\)$"
+}
+
+func sameAny[T any](p, q *T) bool {
+	return p == q
+}
+
+func sameMixed[T interface{ struct{} | int }](p, q *T) bool {
+	return p == q
+}
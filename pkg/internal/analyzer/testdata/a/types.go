@@ -18,7 +18,7 @@ package a
 
 type EmptyType struct{}
 
-var _ map[*EmptyType]*EmptyType // want " \\(zl:typ\\)$" " \\(zl:typ\\)$"
+var _ map[*EmptyType]*EmptyType // want " \\(zl:key\\)$" " \\(zl:typ\\)$"
 
 var _ []*EmptyType // want " \\(zl:typ\\)$"
 
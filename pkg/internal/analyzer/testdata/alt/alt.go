@@ -0,0 +1,62 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package alt
+
+// closedError is returned on closed connections.
+type closedError struct{}
+
+func (*closedError) Error() string { return "closed" } // want " \\(zl:err\\)$"
+
+type state struct {
+	_ [0]func()
+}
+
+func (*state) Reset() {} // want " \\(zl:rcv\\)$"
+
+type (
+	first  struct{}
+	second struct{}
+)
+
+func (*first) Reset() {} // want " \\(zl:rcv\\)$"
+
+func (*second) Reset() {} // want " \\(zl:rcv\\)$"
+
+type conn struct {
+	cur *state // want " \\(zl:fld\\)$"
+}
+
+var defaultState *state // want " \\(zl:var\\)$"
+
+// position is used with unkeyed literals, so no field is added.
+type position struct {
+	line [0]int
+}
+
+var _ = position{[0]int{}}
+
+func (*position) Reset() {} // want " \\(zl:rcv\\)$"
+
+// timeoutError is shared by all timeouts.
+type timeoutError struct{}
+
+func (*timeoutError) Error() string { return "timeout" } // want " \\(zl:err\\)$"
+
+var (
+	ErrReadTimeout  error = &timeoutError{} // want "^variables \"ErrReadTimeout\", \"ErrWriteTimeout\" .* \\(zl:snt\\)$"
+	ErrWriteTimeout error = &timeoutError{}
+)
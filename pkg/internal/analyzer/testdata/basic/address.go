@@ -0,0 +1,49 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package basic
+
+import (
+	"fmt"
+	"reflect"
+	"unsafe"
+)
+
+type addrToken struct{}
+
+func addresses() {
+	h := &addrToken{}
+
+	_ = uintptr(unsafe.Pointer(h)) // want "^address of pointer to zero-size type \"test/basic.addrToken\" converted to uintptr \\(zl:adr\\)$"
+
+	fmt.Printf("%p\n", h) // want "^address of pointer to zero-size type \"test/basic.addrToken\" extracted by fmt.Printf \\(zl:adr\\)$"
+
+	_ = fmt.Sprintf("%d %p", 1, h) // want " \\(zl:adr\\)$"
+
+	_ = reflect.ValueOf(h).Pointer() // want "^address of pointer to zero-size type \"test/basic.addrToken\" extracted by \\(reflect.Value\\).Pointer \\(zl:adr\\)$"
+
+	_ = reflect.ValueOf(h).UnsafePointer() // want " \\(zl:adr\\)$"
+
+	_ = reflect.Value.Pointer(reflect.ValueOf(h)) // want " \\(zl:adr\\)$"
+
+	_ = fmt.Sprintf("%v", h)
+
+	i := 0
+	fmt.Printf("%p\n", &i)
+
+	_ = reflect.ValueOf(&i).Pointer()
+	_ = uintptr(unsafe.Pointer(&i))
+}
@@ -0,0 +1,74 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package basic
+
+import (
+	"errors"
+
+	"github.com/stretchr/testify/require"
+)
+
+type valueError struct{}
+
+func (valueError) Error() string { return "value" }
+
+type pointerError struct{}
+
+func (*pointerError) Error() string { return "pointer" } // want " \\(zl:err\\)$"
+
+func Targets(err error) bool {
+	var target *valueError
+	if errors.As(err, &target) { // want "^target of errors.As is pointer to pointer to zero-size type \"test/basic.valueError\" \\(zl:tgt\\+\\)$"
+		return true
+	}
+
+	var first, second *valueError
+	if errors.As(err, &first) || errors.As(err, &second) { // want " \\(zl:tgt\\+\\)$" " \\(zl:tgt\\+\\)$"
+		return true
+	}
+
+	var p *pointerError
+	if errors.As(err, &p) { // want " \\(zl:tgt\\)$"
+		return true
+	}
+
+	pp := &p
+	if errors.As(err, pp) { // want " \\(zl:tgt\\)$"
+		return true
+	}
+
+	var checked *valueError
+	if errors.As(err, &checked) && checked != nil { // want " \\(zl:tgt\\+\\)$"
+		return checked.Error() != ""
+	}
+
+	var returned *valueError
+	if errors.As(err, &returned) { // want " \\(zl:tgt\\+\\)$"
+		use(returned)
+	}
+
+	var value valueError
+
+	return errors.As(err, &value)
+}
+
+func use(*valueError) {}
+
+func RequireTarget(t require.TestingT, err error) {
+	var target *valueError
+	require.ErrorAs(t, err, &target) // want "^target of github.com/stretchr/testify/require.ErrorAs .* \\(zl:tgt\\+\\)$"
+}
@@ -46,7 +46,7 @@ type embeddedError struct {
 }
 
 var (
-	ErrOne = &myError{}
+	ErrOne = &myError{} // want "^variables \"ErrOne\", \"ErrTwo\" .* \\(zl:snt\\+\\)$"
 	ErrTwo = new(myError)
 )
 
@@ -0,0 +1,72 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package basic
+
+import (
+	"errors"
+	"fmt"
+)
+
+type doneError struct{}
+
+func (doneError) Error() string { return "done" }
+
+type state struct{}
+
+type wrapper struct{ s state }
+
+func current() state { return state{} }
+
+func CompareValues(a, b state, w wrapper, err error) {
+	if a == b { // want "^comparison of values of zero-sized type \"test/basic.state\" is always true \\(zl:cmv\\)$"
+		fmt.Println("equal")
+	}
+
+	if (state{}) != a { // want "^comparison of values of zero-sized type \"test/basic.state\" is always false \\(zl:cmv\\)$"
+		fmt.Println("not equal")
+	}
+
+	if w.s == state(a) { // want " \\(zl:cmv\\)$"
+		fmt.Println("equal")
+	}
+
+	if current() == a { // want " \\(zl:cmv\\)$"
+		fmt.Println("equal")
+	}
+
+	if errors.Is(doneError{}, doneError{}) { // want "^values of zero-sized type \"test/basic.doneError\" always compare equal \\(zl:cmv\\+\\)$"
+		fmt.Println("done")
+	}
+
+	switch a {
+	case b: // want " \\(zl:cmv\\)$"
+		fmt.Println("equal")
+	}
+
+	last := current()
+	if last == a { // want " \\(zl:cmv\\)$"
+		fmt.Println("equal")
+	}
+
+	if errors.Is(err, doneError{}) {
+		fmt.Println("done")
+	}
+
+	if any(a) == any(b) {
+		fmt.Println("equal")
+	}
+}
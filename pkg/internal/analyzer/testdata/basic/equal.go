@@ -0,0 +1,55 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package basic
+
+type canceledError struct{}
+
+func (*canceledError) Error() string { return "canceled" } // want " \\(zl:err\\)$"
+
+func (e *canceledError) Is(target error) bool {
+	return target == e // want "^method Is compares receiver \"e\", a pointer to zero-size type \"test/basic.canceledError\" \\(zl:eqm\\)$"
+}
+
+type closedError struct{}
+
+func (*closedError) Error() string { return "closed" } // want " \\(zl:err\\)$"
+
+func (e *closedError) Is(target error) bool {
+	if target == nil {
+		return false
+	}
+
+	return e == target || target.Error() == e.Error() // want " \\(zl:eqm\\)$"
+}
+
+type marker struct{}
+
+func (m *marker) Equal(other *marker) bool {
+	return m != other // want " \\(zl:eqm\\)$"
+}
+
+func (m *marker) Is(target any, _ int) bool {
+	return m == target // want " \\(zl:cmi\\)$"
+}
+
+func (*marker) Unnamed(_ any) bool { return false }
+
+type matcher struct{}
+
+func (m *matcher) Is(target any) bool {
+	return m == target // want " \\(zl:cmi\\)$"
+}
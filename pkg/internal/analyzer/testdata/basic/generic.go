@@ -0,0 +1,59 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package basic
+
+import (
+	"maps"
+	"slices"
+)
+
+type genericKey struct{}
+
+func Eq[T comparable](a, b T) bool { return a == b }
+
+func Same[T any](a, b T) bool { return false }
+
+type identifier interface {
+	comparable
+	any
+}
+
+func Find[T identifier](s []T, x T) int { return slices.Index(s, x) }
+
+func generics() {
+	errs := []*genericKey{{}}
+	m := &genericKey{}
+
+	_ = slices.Contains(errs, m) // want "^slices.Contains compares pointers to zero-size type \"test/basic.genericKey\" as comparable type parameter E \\(zl:cmg\\)$"
+
+	_ = slices.Index(errs, m) // want " \\(zl:cmg\\)$"
+
+	_ = maps.Equal(map[string]*genericKey{}, map[string]*genericKey{}) // want "^maps.Equal compares .* as comparable type parameter V \\(zl:cmg\\)$"
+
+	_ = Eq(m, m) // want "^test/basic.Eq compares pointers to zero-size type \"test/basic.genericKey\" as comparable type parameter T \\(zl:cmg\\)$"
+
+	_ = Eq[*genericKey](m, nil) // want " \\(zl:cmg\\)$"
+
+	_ = (Eq[*genericKey])(m, m) // want " \\(zl:cmg\\)$"
+
+	_ = Find(errs, m) // want "^test/basic.Find compares .* as comparable type parameter T \\(zl:cmg\\)$"
+
+	_ = Eq(1, 2)
+	_ = Eq(genericKey{}, genericKey{})
+	_ = Same(m, m)
+	_ = slices.ContainsFunc(errs, func(*genericKey) bool { return true })
+}
@@ -0,0 +1,47 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package basic
+
+import "sync"
+
+type handle struct{}
+
+var registry map[*handle]string // want " \\(zl:key\\)$"
+
+func Lookup(h *handle) string {
+	return registry[h] // want " \\(zl:key\\)$"
+}
+
+var handles sync.Map
+
+func Register(h *handle, name string) {
+	handles.Store(h, name) // want " \\(zl:key\\)$"
+
+	if _, ok := handles.Load(&handle{}); ok { // want " \\(zl:key\\)$"
+		registry[h] = name // want " \\(zl:key\\)$"
+	}
+}
+
+func Unregister(h *handle) {
+	m := &handles
+	m.Delete(h)                     // want " \\(zl:key\\)$"
+	(*sync.Map).Delete(m, h)        // want " \\(zl:key\\)$"
+	handles.CompareAndDelete(h, "") // want " \\(zl:key\\)$"
+	handles.Delete("name")
+}
+
+var names = map[string]*handle{}
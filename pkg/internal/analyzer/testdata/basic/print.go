@@ -0,0 +1,77 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package basic
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"testing"
+)
+
+type status struct{}
+
+func (*status) String() string { return "ok" }
+
+type failure struct{}
+
+func (*failure) Error() string { return "failed" } // want " \\(zl:err\\)$"
+
+type plain struct{}
+
+type checkedStatus struct{}
+
+func (s *checkedStatus) String() string {
+	if s == nil {
+		return "<nil>"
+	}
+
+	return "checked"
+}
+
+type valueStatus struct{}
+
+func (valueStatus) String() string { return "value" }
+
+type formatted struct{}
+
+func (formatted) Format(fmt.State, rune) {}
+
+func (*formatted) String() string { return "formatted" }
+
+func Print(l *log.Logger, t *testing.T) {
+	fmt.Println(status{}, plain{}, valueStatus{}, formatted{}, &status{}) // want "^method String of zero-sized type \"test/basic.status\" has a pointer receiver and is not called by fmt.Println \\(zl:prt\\)$"
+	fmt.Fprint(os.Stdout, failure{})                                      // want "^method Error of zero-sized type \"test/basic.failure\" has a pointer receiver and is not called by fmt.Fprint \\(zl:prt\\)$"
+
+	fmt.Printf("%d %v %#v %s %[1]v", 1, status{}, status{}, failure{})
+	fmt.Printf("%*d %v %#v %s %%", 5, 1, status{}, status{}, failure{}) // want " \\(zl:prt\\)$" " \\(zl:prt\\)$"
+	_ = fmt.Sprintf("%.*f %6.2q %x", 2, 3.0, failure{}, status{})       // want " \\(zl:prt\\)$" " \\(zl:prt\\)$"
+	_ = fmt.Errorf("%T %p %v", status{}, &status{}, plain{})            // want " \\(zl:adr\\)$"
+
+	args := []any{status{}}
+	fmt.Println(args...)
+
+	format := "%v"
+	fmt.Printf(format, status{})
+
+	fmt.Println(checkedStatus{}) // want " \\(zl:prt\\)$"
+
+	log.Print(status{})                // want " \\(zl:prt\\)$"
+	l.Printf("%s", failure{})          // want " \\(zl:prt\\)$"
+	t.Logf("%v %v", 1, status{})       // want " \\(zl:prt\\)$"
+	(*log.Logger).Println(l, status{}) // want " \\(zl:prt\\)$"
+}
@@ -0,0 +1,48 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package basic
+
+type stateError struct{}
+
+func (*stateError) Error() string { return "invalid state" } // want " \\(zl:err\\)$"
+
+var (
+	ErrClosed  error = &stateError{} // want "^variables \"ErrClosed\", \"ErrPending\", \"ErrTimeout\" are initialized with pointers to zero-sized type \"test/basic.stateError\" .* \\(zl:snt\\)$"
+	ErrPending error = new(stateError)
+	_          error = &stateError{}
+)
+
+var ErrTimeout = &stateError{}
+
+type token struct{}
+
+var tokenA, tokenB = &token{}, &token{} // want "^variables \"tokenA\", \"tokenB\" .* \\(zl:snt\\)$"
+
+var (
+	single   = &sentinelOnly{}
+	counterA = new(int)
+	counterB = new(int)
+)
+
+type sentinelOnly struct{}
+
+func Tokens() (*token, *token, *sentinelOnly, *int, *int) {
+	var localA, localB = &token{}, &token{}
+	_, _ = localA, localB
+
+	return tokenA, tokenB, single, counterA, counterB
+}
@@ -0,0 +1,270 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analyzer
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis/passes/buildssa"
+	"golang.org/x/tools/go/ast/inspector"
+	"golang.org/x/tools/go/ssa"
+
+	"fillmore-labs.com/zerolint/pkg/internal/analyzer/msg"
+)
+
+// typedNil tracks interface values that might hold a nil pointer to a zero-sized type.
+type typedNil struct {
+	v       *Visitor
+	pkg     *ssa.Package
+	results map[*ssa.Function][]types.Type // Per result, the pointer type a returned interface might hold as nil.
+	nils    map[*ssa.Function][]bool       // Per result, whether a returned pointer might be nil.
+}
+
+// checkTypedNil reports comparisons of interfaces with nil, where the interface might hold a nil pointer
+// to a zero-sized type. Such an interface is not nil, so the comparison does not detect the nil pointer.
+func (v *Visitor) checkTypedNil(root inspector.Cursor, ssaInfo *buildssa.SSA) {
+	t := typedNil{
+		v:       v,
+		pkg:     ssaInfo.Pkg,
+		results: make(map[*ssa.Function][]types.Type),
+		nils:    make(map[*ssa.Function][]bool),
+	}
+
+	for _, fn := range ssaInfo.SrcFuncs {
+		if !v.Generated && isGenerated(v.Diag.File(fn.Pos())) {
+			continue
+		}
+
+		for _, b := range fn.Blocks {
+			for _, instr := range b.Instrs {
+				op, ok := instr.(*ssa.BinOp)
+				if !ok || op.Op != token.EQL && op.Op != token.NEQ {
+					continue
+				}
+
+				x, ok := nilComparison(op)
+				if !ok {
+					continue
+				}
+
+				if ptr := t.holdsNil(x, make(map[ssa.Value]struct{})); ptr != nil {
+					v.reportTypedNil(root, op.Pos(), ptr)
+				}
+			}
+		}
+	}
+}
+
+// reportTypedNil reports the comparison with the operator at the given position.
+func (v *Visitor) reportTypedNil(root inspector.Cursor, opPos token.Pos, ptr types.Type) {
+	if !opPos.IsValid() {
+		return
+	}
+
+	c, ok := root.FindByPos(opPos, opPos)
+	if !ok {
+		return
+	}
+
+	n, ok := c.Node().(*ast.BinaryExpr)
+	if !ok || n.OpPos != opPos || !v.isInterfaceOrNil(n.X) || !v.isInterfaceOrNil(n.Y) {
+		return // Explicit conversions in the comparison are reported by visitCmp.
+	}
+
+	elem, valueMethod, zeroSized := v.Check.ZeroSizedTypePointer(ptr)
+	if !zeroSized { // should not happen
+		return
+	}
+
+	cM := msg.Formatf(msg.CatTypedNil, valueMethod,
+		"comparison with nil does not detect nil pointer to zero-sized type %q converted to interface", elem)
+	v.Diag.Report(n, cM, nil)
+}
+
+// isInterfaceOrNil checks whether x is of interface type or the predeclared nil.
+func (v *Visitor) isInterfaceOrNil(x ast.Expr) bool {
+	tv, ok := v.Diag.TypesInfo().Types[x]
+
+	return ok && (tv.IsNil() || types.IsInterface(tv.Type))
+}
+
+// nilComparison returns the interface operand of a comparison with nil.
+func nilComparison(op *ssa.BinOp) (ssa.Value, bool) {
+	x, y := op.X, op.Y
+	if isNilConst(x) {
+		x, y = y, x
+	}
+
+	if !isNilConst(y) || !types.IsInterface(x.Type()) {
+		return nil, false
+	}
+
+	return x, true
+}
+
+// isNilConst checks whether v is the constant nil.
+func isNilConst(v ssa.Value) bool {
+	c, ok := v.(*ssa.Const)
+
+	return ok && c.IsNil()
+}
+
+// holdsNil returns the pointer to zero-sized type an interface value might hold as nil, or nil if there is none.
+func (t *typedNil) holdsNil(x ssa.Value, seen map[ssa.Value]struct{}) types.Type {
+	if _, ok := seen[x]; ok {
+		return nil
+	}
+
+	seen[x] = struct{}{}
+
+	switch x := x.(type) {
+	case *ssa.MakeInterface:
+		if _, _, zeroSized := t.v.Check.ZeroSizedTypePointer(x.X.Type()); zeroSized && t.mayBeNil(x.X, make(map[ssa.Value]struct{})) {
+			return x.X.Type()
+		}
+
+	case *ssa.ChangeInterface:
+		return t.holdsNil(x.X, seen)
+
+	case *ssa.Phi:
+		for _, e := range x.Edges {
+			if ptr := t.holdsNil(e, seen); ptr != nil {
+				return ptr
+			}
+		}
+
+	case *ssa.Call:
+		return t.resultHoldsNil(x.Call.StaticCallee(), 0)
+
+	case *ssa.Extract:
+		if call, ok := x.Tuple.(*ssa.Call); ok {
+			return t.resultHoldsNil(call.Call.StaticCallee(), x.Index)
+		}
+	}
+
+	return nil
+}
+
+// resultHoldsNil returns the pointer to zero-sized type the interface result i of a function in the current package
+// might hold as nil, or nil if there is none.
+func (t *typedNil) resultHoldsNil(fn *ssa.Function, i int) types.Type {
+	if fn == nil || fn.Package() != t.pkg || fn.Blocks == nil {
+		return nil
+	}
+
+	res, ok := t.results[fn]
+	if !ok {
+		n := fn.Signature.Results().Len()
+		res = make([]types.Type, n)
+		t.results[fn] = res // Break cycles of recursive functions.
+
+		for _, b := range fn.Blocks {
+			ret, ok := b.Instrs[len(b.Instrs)-1].(*ssa.Return)
+			if !ok || len(ret.Results) != n {
+				continue
+			}
+
+			for j, r := range ret.Results {
+				if res[j] == nil && types.IsInterface(r.Type()) {
+					res[j] = t.holdsNil(r, make(map[ssa.Value]struct{}))
+				}
+			}
+		}
+	}
+
+	if i >= len(res) {
+		return nil
+	}
+
+	return res[i]
+}
+
+// mayBeNil checks whether the nil constant might reach the pointer x, directly or returned from a function
+// in the current package. Pointers of unknown origin, like parameters or loaded values, are assumed not to be nil.
+func (t *typedNil) mayBeNil(x ssa.Value, seen map[ssa.Value]struct{}) bool {
+	if _, ok := seen[x]; ok {
+		return false
+	}
+
+	seen[x] = struct{}{}
+
+	switch x := x.(type) {
+	case *ssa.Const:
+		return x.IsNil()
+
+	case *ssa.ChangeType:
+		return t.mayBeNil(x.X, seen)
+
+	case *ssa.Phi:
+		for _, e := range x.Edges {
+			if t.mayBeNil(e, seen) {
+				return true
+			}
+		}
+
+		return false
+
+	case *ssa.Call:
+		return t.resultMayBeNil(x.Call.StaticCallee(), 0)
+
+	case *ssa.Extract:
+		if call, ok := x.Tuple.(*ssa.Call); ok {
+			return t.resultMayBeNil(call.Call.StaticCallee(), x.Index)
+		}
+
+		return false
+
+	default:
+		return false
+	}
+}
+
+// resultMayBeNil checks whether the pointer result i of a function in the current package might be nil.
+func (t *typedNil) resultMayBeNil(fn *ssa.Function, i int) bool {
+	if fn == nil || fn.Package() != t.pkg || fn.Blocks == nil {
+		return false
+	}
+
+	res, ok := t.nils[fn]
+	if !ok {
+		n := fn.Signature.Results().Len()
+		res = make([]bool, n)
+		t.nils[fn] = res // Break cycles of recursive functions.
+
+		for _, b := range fn.Blocks {
+			ret, ok := b.Instrs[len(b.Instrs)-1].(*ssa.Return)
+			if !ok || len(ret.Results) != n {
+				continue
+			}
+
+			for j, r := range ret.Results {
+				if !res[j] {
+					res[j] = t.mayBeNil(r, make(map[ssa.Value]struct{}))
+				}
+			}
+		}
+	}
+
+	return i < len(res) && res[i]
+}
+
+// isGenerated checks whether the file is nil or generated.
+func isGenerated(f *ast.File) bool {
+	return f == nil || ast.IsGenerated(f)
+}
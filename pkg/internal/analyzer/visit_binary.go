@@ -28,6 +28,20 @@ func (v *Visitor) visitBinary(n *ast.BinaryExpr) bool {
 		return true
 	}
 
+	if v.cmpSeen(n) { // Already reported as part of an equality method.
+		return true
+	}
+
 	// Delegate to visitCmp for further analysis of the comparison.
 	return v.visitCmp(n, n.X, n.Y)
 }
+
+// ignoreCmp ignores the comparison in further processing, since it has already been reported.
+func (v *Visitor) ignoreCmp(n *ast.BinaryExpr) {
+	v.seenCmps.Add(n.Pos())
+}
+
+// cmpSeen checks if a given comparison has already been processed.
+func (v *Visitor) cmpSeen(n *ast.BinaryExpr) bool {
+	return v.seenCmps.Contains(n.Pos())
+}
@@ -30,6 +30,7 @@ import (
 //   - Type conversions, such as (*T)(nil).
 //   - Function and method calls, checking for nil arguments to zero-sized pointer
 //     parameters and special-casing functions like errors.Is or json.Unmarshal.
+//   - Generic function calls comparing pointers to zero-sized types, such as slices.Contains.
 func (v *Visitor) visitCall(n *ast.CallExpr) bool {
 	switch funType := v.Diag.TypesInfo().Types[n.Fun]; {
 	case funType.IsBuiltin(): // Check for calls to new(T).
@@ -40,10 +41,6 @@ func (v *Visitor) visitCall(n *ast.CallExpr) bool {
 		return v.visitBuiltin(n)
 
 	case funType.IsType(): // Check for type casts T(...).
-		if v.Level.Below(level.Extended) {
-			return true
-		}
-
 		return v.visitCast(n, funType.Type)
 
 	case funType.IsValue():
@@ -62,6 +59,8 @@ func (v *Visitor) visitCall(n *ast.CallExpr) bool {
 			return false
 		}
 
+		v.visitGenericCall(n, fun) // Check comparable type parameters instantiated with pointers to zero-sized types.
+
 		if methodExpr && v.Level.AtLeast(level.Extended) {
 			if e, ok := ast.Unparen(n.Fun).(*ast.SelectorExpr); ok {
 				// Selection expression
@@ -19,16 +19,38 @@ package analyzer
 import (
 	"go/ast"
 
+	"golang.org/x/tools/go/analysis"
+
 	"fillmore-labs.com/zerolint/pkg/internal/analyzer/msg"
 )
 
-// visitBuiltin examines calls to new(T), where T is a zero-sized type.
+// visitBuiltin examines calls to new(T), where T is a zero-sized type, and make(map[K]V, n),
+// where K is a zero-sized type.
 func (v *Visitor) visitBuiltin(n *ast.CallExpr) bool {
+	fun, ok := ast.Unparen(n.Fun).(*ast.Ident)
+	if !ok {
+		return true
+	}
+
+	switch fun.Name {
+	case "new":
+		return v.visitNew(n)
+
+	case "make":
+		return v.visitMakeMap(n)
+
+	default:
+		return true
+	}
+}
+
+// visitNew examines calls to new(T), where T is a zero-sized type.
+func (v *Visitor) visitNew(n *ast.CallExpr) bool {
 	if len(n.Args) != 1 {
 		return true
 	}
 
-	if fun, ok := ast.Unparen(n.Fun).(*ast.Ident); !ok || fun.Name != "new" {
+	if v.flagSeen(n) { // Rewritten by a boolean flag fix.
 		return true
 	}
 
@@ -41,7 +63,12 @@ func (v *Visitor) visitBuiltin(n *ast.CallExpr) bool {
 	}
 
 	cM := msg.Formatf(msg.CatNew, valueMethod, "new called on zero-sized type %q", argType)
-	fixes := v.Diag.MakePure(n, arg)
+
+	var fixes []analysis.SuggestedFix
+	if !v.pointerKept(n) { // The address is passed to an identity-sensitive function or used as map key.
+		fixes = v.removeAddress(n, v.Diag.MakePure(n, arg), argType)
+	}
+
 	v.Diag.Report(n, cM, fixes)
 
 	return len(fixes) == 0
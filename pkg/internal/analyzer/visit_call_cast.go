@@ -23,11 +23,13 @@ import (
 	"golang.org/x/tools/go/analysis"
 
 	"fillmore-labs.com/zerolint/pkg/internal/analyzer/msg"
+	"fillmore-labs.com/zerolint/pkg/zerolint/level"
 )
 
 // visitCast checks for type casts:
 // - nil to pointers of zero-sized types, like (*struct{})(nil).
 // - casts of pointers of zero-sized types to [unsafe.Pointer], like unsafe.Pointer(&struct{}{}).
+// - casts of pointers of zero-sized types to uintptr, like uintptr(unsafe.Pointer(&struct{}{})).
 func (v *Visitor) visitCast(n *ast.CallExpr, t types.Type) bool {
 	if len(n.Args) != 1 { // should not happen
 		v.Diag.LogErrorf(n, "Expected one argument, got %d", len(n.Args))
@@ -37,8 +39,17 @@ func (v *Visitor) visitCast(n *ast.CallExpr, t types.Type) bool {
 
 	arg := n.Args[0]
 
+	// Check for uintptr(unsafe.Pointer(arg))
+	if b, ok := t.(*types.Basic); ok && b.Kind() == types.Uintptr {
+		return v.visitUintptrCast(n, arg)
+	}
+
+	if v.Level.Below(level.Extended) {
+		return true
+	}
+
 	// Check for unsafe.Pointer(arg)
-	if b, ok := t.(*types.Basic); ok && b.Kind() == types.UnsafePointer {
+	if isUnsafePointer(t) {
 		tv, ok := v.Diag.TypesInfo().Types[arg]
 		if !ok { // should not happen
 			v.Diag.LogErrorf(arg, "Can't find unsafe cast type")
@@ -90,3 +101,40 @@ func (v *Visitor) visitCast(n *ast.CallExpr, t types.Type) bool {
 
 	return true // Descend into the argument expression
 }
+
+// visitUintptrCast checks for casts of pointers to zero-sized types to uintptr via [unsafe.Pointer],
+// which are commonly used as identity.
+func (v *Visitor) visitUintptrCast(n *ast.CallExpr, arg ast.Expr) bool {
+	c, ok := ast.Unparen(arg).(*ast.CallExpr)
+	if !ok || len(c.Args) != 1 {
+		return true
+	}
+
+	if tv := v.Diag.TypesInfo().Types[c.Fun]; !tv.IsType() || !isUnsafePointer(tv.Type) {
+		return true
+	}
+
+	tv, ok := v.Diag.TypesInfo().Types[c.Args[0]]
+	if !ok { // should not happen
+		v.Diag.LogErrorf(c.Args[0], "Can't find unsafe cast type")
+
+		return true
+	}
+
+	elem, valueMethod, zeroSized := v.Check.ZeroSizedTypePointer(tv.Type)
+	if !zeroSized {
+		return true // Not a pointer to a zero-sized type.
+	}
+
+	cM := msg.Formatf(msg.CatAddressValue, valueMethod, "address of pointer to zero-size type %q converted to uintptr", elem)
+	v.Diag.Report(n, cM, nil)
+
+	return false // Don't report the inner unsafe.Pointer cast again.
+}
+
+// isUnsafePointer checks whether t is [unsafe.Pointer].
+func isUnsafePointer(t types.Type) bool {
+	b, ok := t.(*types.Basic)
+
+	return ok && b.Kind() == types.UnsafePointer
+}
@@ -24,9 +24,11 @@ import (
 )
 
 // visitCallFunc processes encoding/json.Unmarshal (ignored as it requires pointer arguments),
-// errors.Is and errors.As from the standard library or golang.org/x/exp/errors.
+// errors.Is and errors.As (checking the target) from the standard library or golang.org/x/exp/errors, context keys,
+// sync.Map keys, identity-sensitive functions like runtime.SetFinalizer, print-style functions like fmt.Printf
+// and address extraction with reflect.Value.Pointer.
 func (v *Visitor) visitCallFunc(n *ast.CallExpr, fun *types.Func, methodExpr bool) bool {
-	if len(n.Args) == 0 { // Plain function call
+	if len(n.Args) == 0 && fun.Signature().Recv() == nil { // Plain function call without arguments
 		return true
 	}
 
@@ -55,6 +57,75 @@ func (v *Visitor) visitCallFunc(n *ast.CallExpr, fun *types.Func, methodExpr boo
 
 			return v.visitCmp(n, n.Args[base+1], n.Args[base+2]) // Delegate analysis of ErrorIs(t, ..., ...) to visitCmp.
 
+		case funcKey0:
+			if len(n.Args) < base+1 { // Multi-valued argument
+				return true
+			}
+
+			return v.visitContextKey(n.Args[base]) // Analyze the key of ctx.Value(...).
+
+		case funcKey1:
+			if len(n.Args) < base+3 { // Multi-valued argument
+				return true
+			}
+
+			return v.visitContextKey(n.Args[base+1]) // Analyze the key of context.WithValue(ctx, ..., val).
+
+		case funcIdentity0:
+			if len(n.Args) < base+1 { // Multi-valued argument
+				return true
+			}
+
+			return v.visitIdentity(n.Args[base], funcName) // Analyze the object of runtime.SetFinalizer(..., ...).
+
+		case funcMapKey0:
+			if len(n.Args) < base+1 { // Multi-valued argument
+				return true
+			}
+
+			return v.visitSyncMapKey(n.Args[base]) // Analyze the key of m.Load(...), m.Store(..., ...), etc.
+
+		case funcAs1:
+			if len(n.Args) < base+2 { // Multi-valued argument
+				return false
+			}
+
+			return v.visitAsTarget(n.Args[base+1], funcName) // Analyze the target of errors.As(..., ...).
+
+		case funcAs2:
+			if len(n.Args) < base+3 { // Multi-valued argument
+				return false
+			}
+
+			return v.visitAsTarget(n.Args[base+2], funcName) // Analyze the target of ErrorAs(t, ..., ...).
+
+		case funcPrint0:
+			return v.visitPrint(n, n.Args[base:], funcName) // Analyze the arguments of fmt.Print(...).
+
+		case funcPrint1:
+			if len(n.Args) < base+1 { // Multi-valued argument
+				return true
+			}
+
+			return v.visitPrint(n, n.Args[base+1:], funcName) // Analyze the arguments of fmt.Fprint(w, ...).
+
+		case funcPrintf0:
+			if len(n.Args) < base+1 { // Multi-valued argument
+				return true
+			}
+
+			return v.visitPrintf(n, n.Args[base], n.Args[base+1:], funcName) // Analyze fmt.Printf(format, ...).
+
+		case funcPrintf1:
+			if len(n.Args) < base+2 { // Multi-valued argument
+				return true
+			}
+
+			return v.visitPrintf(n, n.Args[base+1], n.Args[base+2:], funcName) // Analyze fmt.Fprintf(w, format, ...).
+
+		case funcAddress:
+			return v.visitReflectAddress(n, methodExpr, funcName) // Analyze reflect.ValueOf(...).Pointer().
+
 		case funcNone: // should not happen
 			v.Diag.LogErrorf(n, "Unconfigured function %s", funcName)
 
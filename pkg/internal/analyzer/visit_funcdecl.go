@@ -62,6 +62,7 @@ func isCfunc(name string) bool {
 //
 // Special handling applies to:
 //   - Error() methods: Always checked and reported.
+//   - Is(error) bool and Equal(T) bool methods: Comparisons of the receiver are always checked and reported.
 //   - Lock()/Unlock() methods: Star removal is not suggested.
 func (v *Visitor) visitFuncRecv(n *ast.FuncDecl) {
 	if n.Recv == nil || len(n.Recv.List) != 1 {
@@ -76,6 +77,10 @@ func (v *Visitor) visitFuncRecv(n *ast.FuncDecl) {
 		return
 	}
 
+	if isEqualDecl(v.Diag.TypesInfo(), n) {
+		v.checkEqualMethod(n, elem, valueMethod)
+	}
+
 	var cM diag.CategorizedMessage
 
 	switch {
@@ -102,8 +107,8 @@ func (v *Visitor) visitFuncRecv(n *ast.FuncDecl) {
 			"method %s has pointer receiver to zero-sized type %q", n.Name.Name, elem)
 	}
 
-	fixes := v.removeStar(p)
-	v.Diag.Report(p, cM, fixes)
+	fixes := v.removeStarOf(p, n.Recv.List[0].Names, nil, elem)
+	v.Diag.Report(p, cM, fixes, v.typeAlternatives(elem))
 }
 
 // isErrorDecl checks if a function declaration has the signature of the standard
@@ -55,7 +55,7 @@ func (v *Visitor) visitStar(n *ast.StarExpr) bool {
 		// type T struct{}; map[]*T, []*T, f[*T]
 		cM := msg.Formatf(msg.CatStarType, valueMethod, "pointer to zero-sized type %q", t)
 		fixes := v.Diag.RemoveOp(n, n.X)
-		v.Diag.Report(n, cM, fixes)
+		v.Diag.Report(n, cM, fixes, v.typeAlternatives(t))
 
 		return len(fixes) == 0
 	}
@@ -26,8 +26,13 @@ import (
 // visitStructType analyzes struct type declarations for fields or embedded types
 // that are pointers to zero-sized types.
 // If the lint level is `Default` (i.e., `v.level.Below(level.Extended)` is true), it only checks embedded types.
+// Otherwise, it also checks for trailing zero-sized fields that add padding.
 func (v *Visitor) visitStructType(n *ast.StructType) bool {
 	v.checkFieldList(n.Fields, v.Level.Below(level.Extended), msg.Struct{})
 
+	if v.Level.AtLeast(level.Extended) {
+		v.checkTrailingField(n)
+	}
+
 	return true
 }
@@ -0,0 +1,40 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analyzer
+
+import "go/ast"
+
+// visitSwitch checks the case expressions of expression switch statements, which are compared to the tag like `==`.
+func (v *Visitor) visitSwitch(n *ast.SwitchStmt) bool {
+	if n.Tag == nil { // switch { case x == y: ... } is handled by visitBinary.
+		return true
+	}
+
+	for _, b := range n.Body.List {
+		c, ok := b.(*ast.CaseClause)
+		if !ok { // should not happen
+			continue
+		}
+
+		for _, x := range c.List {
+			// Delegate to visitCmp for further analysis of the comparison.
+			v.visitCmp(x, n.Tag, x)
+		}
+	}
+
+	return true
+}
@@ -34,7 +34,7 @@ func (v *Visitor) visitTypeSpec(n *ast.TypeSpec) bool {
 		cM := msg.Formatf(msg.CatTypeDeclaration, valueMethod,
 			"type declaration to pointer to zero-sized type %q", elem)
 		fixes := v.removeStar(n.Type)
-		v.Diag.Report(n, cM, fixes)
+		v.Diag.Report(n, cM, fixes, v.typeAlternatives(elem))
 	}
 
 	return true
@@ -20,6 +20,8 @@ import (
 	"go/ast"
 	"go/token"
 
+	"golang.org/x/tools/go/analysis"
+
 	"fillmore-labs.com/zerolint/pkg/internal/analyzer/msg"
 )
 
@@ -29,6 +31,10 @@ func (v *Visitor) visitUnary(n *ast.UnaryExpr) bool {
 		return true
 	}
 
+	if v.flagSeen(n) { // Rewritten by a boolean flag fix.
+		return true
+	}
+
 	// &...
 	t := v.Diag.TypesInfo().TypeOf(n.X)
 
@@ -38,7 +44,12 @@ func (v *Visitor) visitUnary(n *ast.UnaryExpr) bool {
 	}
 
 	cM := msg.Formatf(msg.CatAddress, valueMethod, "address of zero-size variable of type %q", t)
-	fixes := v.Diag.RemoveOp(n, n.X)
+
+	var fixes []analysis.SuggestedFix
+	if !v.pointerKept(n) { // The address is passed to an identity-sensitive function or used as map key.
+		fixes = v.removeAddress(n, v.Diag.RemoveOp(n, n.X), t)
+	}
+
 	v.Diag.Report(n, cM, fixes)
 
 	return len(fixes) == 0
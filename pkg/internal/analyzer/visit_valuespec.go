@@ -26,7 +26,7 @@ import (
 // visitValueSpec analyzes variable declarations (`var` or `const` specs)
 // to detect if they explicitly declare variables as pointers to zero-sized types.
 func (v *Visitor) visitValueSpec(n *ast.ValueSpec) bool {
-	if n.Type == nil {
+	if n.Type == nil || v.flagSeen(n.Type) {
 		return true
 	}
 
@@ -51,8 +51,8 @@ func (v *Visitor) visitValueSpec(n *ast.ValueSpec) bool {
 	}
 
 	cM := msg.FormatMessage(msg.Value{}, elem, valueMethod, n.Names)
-	fixes := v.removeStar(n.Type)
-	v.Diag.Report(n, cM, fixes)
+	fixes := v.removeStarOf(n.Type, n.Names, n.Values, elem)
+	v.Diag.Report(n, cM, fixes, v.typeAlternatives(elem))
 
 	return true
 }
@@ -18,15 +18,19 @@ package analyzer
 
 import (
 	"errors"
+	"go/ast"
 	"go/token"
+	"go/types"
 
 	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/buildssa"
 	"golang.org/x/tools/go/analysis/passes/inspect"
 	"golang.org/x/tools/go/ast/inspector"
 
 	"fillmore-labs.com/zerolint/pkg/internal/checker"
 	"fillmore-labs.com/zerolint/pkg/internal/diag"
 	"fillmore-labs.com/zerolint/pkg/internal/filter"
+	"fillmore-labs.com/zerolint/pkg/internal/passes/construction"
 	"fillmore-labs.com/zerolint/pkg/internal/passes/exclusions"
 	"fillmore-labs.com/zerolint/pkg/internal/set"
 	"fillmore-labs.com/zerolint/pkg/zerolint/level"
@@ -41,8 +45,26 @@ type Visitor struct {
 	Level     level.LintLevel // Analysis level.
 	Generated bool            // Analyze generated source, too.
 
+	Alternatives bool // Offer alternative fixes, like making a type non-zero-sized.
+
 	// Tracks *[ast.StarExpr] positions that have already been processed to avoid duplicate diagnostics or fixes.
 	seenStars set.Set[token.Pos]
+
+	// Tracks *[ast.BinaryExpr] positions that have already been reported to avoid duplicate diagnostics.
+	seenCmps set.Set[token.Pos]
+
+	// Tracks positions of declarations and values rewritten by boolean flag fixes.
+	seenFlags set.Set[token.Pos]
+
+	// Tracks positions of allocations passed to identity-sensitive functions or used as map keys,
+	// which must stay pointers.
+	keptPointers set.Set[token.Pos]
+
+	// Root of the syntax trees of the current package, used to find the context of identifier uses.
+	root inspector.Cursor
+
+	// Uses of objects in the current package, built on demand by [Visitor.usesOf].
+	uses map[types.Object][]*ast.Ident
 }
 
 // ErrNoInspectorResult is returned when the ast inspector is missing.
@@ -50,9 +72,13 @@ var ErrNoInspectorResult = errors.New("zerolint: inspector result missing")
 
 // Run ...
 func (v *Visitor) Run(pass *analysis.Pass) (any, error) {
+	v.Check.Sizes = pass.TypesSizes
 	v.Check.Prepare()
 	v.Diag.Prepare(pass)
 	v.seenStars = make(set.Set[token.Pos])
+	v.seenCmps = make(set.Set[token.Pos])
+	v.seenFlags = make(set.Set[token.Pos])
+	v.keptPointers = make(set.Set[token.Pos])
 
 	if excludedTypeDefs, err := exclusions.CalculateExclusions(pass); err == nil {
 		v.Check.ExcludedTypeDefs = filter.New(excludedTypeDefs)
@@ -60,13 +86,37 @@ func (v *Visitor) Run(pass *analysis.Pass) (any, error) {
 		return nil, err
 	}
 
+	constructions, err := construction.ResultOf(pass)
+	if err != nil && !errors.Is(err, construction.ErrNoConstructionResult) {
+		return nil, err
+	}
+
 	in, ok := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
 	if !ok {
 		return nil, ErrNoInspectorResult
 	}
 
-	types := v.nodeFilter()
-	in.Root().Inspect(types, v.dispatch)
+	v.root = in.Root()
+	v.uses = nil
+
+	if v.Level.AtLeast(level.Extended) {
+		v.checkFlags(in.Root()) // Before visiting, to suppress diagnostics for flags.
+	}
+
+	v.checkKeptPointers(in.Root()) // Before visiting, to suppress fixes of allocations that must stay pointers.
+
+	nodes := v.nodeFilter()
+	in.Root().Inspect(nodes, v.dispatch)
+
+	v.checkSentinels(in.Root())
+
+	if v.Level.AtLeast(level.Extended) {
+		if ssaInfo, ok := pass.ResultOf[buildssa.Analyzer].(*buildssa.SSA); ok {
+			v.checkTypedNil(in.Root(), ssaInfo)
+		}
+
+		v.checkConstructions(constructions)
+	}
 
 	return result.New(v.Check.Detected), nil
 }
@@ -17,6 +17,8 @@
 package checker
 
 import (
+	"go/types"
+	"reflect"
 	"regexp"
 
 	"golang.org/x/tools/go/types/typeutil"
@@ -30,6 +32,15 @@ type Checker struct {
 	// Cached results of zero-sized checks, used by [Checker.ZeroSizedType] to optimize repeated lookups.
 	cache typeutil.Map
 
+	// Zero-size computation with memoized intermediate results.
+	sizer Sizer
+
+	// Key of the sizes the caches are valid for, see [sizesKey].
+	sizesKey any
+
+	// Sizes of the target platform, see [Sizer.Sizes]. Optional.
+	Sizes types.Sizes
+
 	// Type definitions excluded via `//nolint:zerolint` directives.
 	ExcludedTypeDefs filter.Filter
 
@@ -48,4 +59,20 @@ func (c *Checker) Prepare() {
 	}
 
 	c.Detected = make(map[string]bool)
+
+	// Cached results are only valid for the same platform.
+	if key := sizesKey(c.Sizes); key == nil || key != c.sizesKey {
+		c.sizer = Sizer{Sizes: c.Sizes}
+		c.cache = typeutil.Map{}
+		c.sizesKey = key
+	}
+}
+
+// sizesKey returns a key identifying sizes, or nil when sizes are missing or can't be compared safely.
+func sizesKey(sizes types.Sizes) any {
+	if sizes == nil || !reflect.ValueOf(sizes).Comparable() {
+		return nil
+	}
+
+	return sizes
 }
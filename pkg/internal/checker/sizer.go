@@ -0,0 +1,121 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package checker
+
+import "go/types"
+
+// Sizer determines whether types are zero-sized, memoizing intermediate results.
+// Unlike a depth-limited walk, it handles arbitrarily deep nesting and stops at cycles,
+// which only occur in invalid recursive types.
+//
+// The zero value is ready to use.
+type Sizer struct {
+	// Sizes of the target platform. When nil, structs and arrays are zero-sized when all their elements are,
+	// and other types are considered non-zero-sized.
+	Sizes types.Sizes
+
+	memo map[types.Type]sizeState
+}
+
+// sizeState is the memoized zero-size state of a type.
+type sizeState uint8
+
+const (
+	sizeVisiting sizeState = iota + 1 // On the current path, a cycle when encountered again.
+	sizeZero
+	sizeZeroAlways // Zero-sized without consulting Sizes, like [0]T, or by the constraint of a type parameter.
+	sizeNonZero
+)
+
+// ZeroSized determines whether the type t is provably zero-sized.
+// A type parameter is zero-sized when every type in its type set is.
+func (s *Sizer) ZeroSized(t types.Type) bool {
+	state := s.state(t)
+
+	return state == sizeZero || state == sizeZeroAlways
+}
+
+// state returns the memoized zero-size state of t, computing it when necessary.
+func (s *Sizer) state(t types.Type) sizeState {
+	if s.memo == nil {
+		s.memo = make(map[types.Type]sizeState)
+	}
+
+	switch state := s.memo[t]; state {
+	case sizeZero, sizeZeroAlways, sizeNonZero:
+		return state
+
+	case sizeVisiting: // A cycle means an invalid recursive type.
+		return sizeNonZero
+	}
+
+	s.memo[t] = sizeVisiting
+
+	state := s.zeroSized(t)
+	s.memo[t] = state
+
+	return state
+}
+
+func (s *Sizer) zeroSized(t types.Type) sizeState {
+	if tp, ok := t.(*types.TypeParam); ok {
+		if typeSetAll(tp.Constraint(), s.ZeroSized) {
+			return sizeZeroAlways
+		}
+
+		return sizeNonZero
+	}
+
+	var state sizeState
+
+	switch u := t.Underlying().(type) {
+	case *types.Array:
+		if u.Len() == 0 { // The element type might contain type parameters, which Sizes can't handle.
+			return sizeZeroAlways
+		}
+
+		state = s.state(u.Elem())
+
+	case *types.Struct:
+		state = sizeZero
+
+		for field := range u.Fields() {
+			switch s.state(field.Type()) {
+			case sizeNonZero:
+				return sizeNonZero
+
+			case sizeZeroAlways:
+				state = sizeZeroAlways
+			}
+		}
+
+	default:
+		// Other types (Basic, Chan, Interface, Map, Pointer, Signature, Slice) have a platform-dependent size.
+		if b, ok := u.(*types.Basic); ok && b.Kind() == types.Invalid || s.Sizes == nil || s.Sizes.Sizeof(t) != 0 {
+			return sizeNonZero
+		}
+
+		return sizeZero
+	}
+
+	// The structure is free of cycles and type parameters, so the platform decides about padding and layout.
+	if state == sizeZero && s.Sizes != nil && s.Sizes.Sizeof(t) != 0 {
+		return sizeNonZero
+	}
+
+	return state
+}
@@ -54,6 +54,10 @@ func (c *Checker) ZeroSizedType(t types.Type) (valueMethod, zeroSized bool) {
 		return false, false
 	}
 
+	if tp, ok := t.(*types.TypeParam); ok {
+		return c.typeParamZeroSized(tp)
+	}
+
 	vM, zS := c.lookupOrCalculate(t)
 
 	// The cached zS value reflects structural zero-sizedness. We can't return it directly,
@@ -82,7 +86,7 @@ func (c *Checker) lookupOrCalculate(t types.Type) (valueMethod, zeroSized bool)
 	}
 
 	// Check if the underlying type is zero-sized.
-	if !ZeroSized(t, 0) {
+	if !c.sizer.ZeroSized(t) {
 		// Cache the result.
 		c.cache.Set(t, typeCache{zeroSized: false})
 
@@ -97,6 +101,21 @@ func (c *Checker) lookupOrCalculate(t types.Type) (valueMethod, zeroSized bool)
 	return vM, true
 }
 
+// typeParamZeroSized determines whether every type in the type set of tp is zero-sized, considering exclusions.
+// The type parameter itself is not tracked, since the terms of its constraint are.
+func (c *Checker) typeParamZeroSized(tp *types.TypeParam) (valueMethod, zeroSized bool) {
+	zS := typeSetAll(tp.Constraint(), func(t types.Type) bool {
+		_, zS := c.ZeroSizedType(t)
+
+		return zS
+	})
+	if !zS {
+		return false, false
+	}
+
+	return hasValueMethod(tp), true
+}
+
 // hasValueMethod checks if a type has any methods with a value receiver.
 func hasValueMethod(t types.Type) bool {
 	mset := types.NewMethodSet(t)
@@ -133,11 +152,11 @@ func (c *Checker) ignored(t types.Type) bool {
 		tn = t.Obj()
 		// Check below
 
-	case *types.Struct, *types.Array:
+	case *types.Struct, *types.Array, *types.TypeParam:
 		return false
 
 	default:
-		// Other types (Basic, Chan, Interface, Map, Pointer, Signature, Slice, TypeParam)
+		// Other types (Basic, Chan, Interface, Map, Pointer, Signature, Slice)
 		// are not zero-sized, so they are not considered for analysis.
 		return true
 	}
@@ -146,25 +165,38 @@ func (c *Checker) ignored(t types.Type) bool {
 	return c.ExcludedTypeDefs.ExcludedType(tn)
 }
 
-const maxDepth = 10
-
 // ZeroSized determines whether the type t is provably zero-sized.
-func ZeroSized(typ types.Type, depth int) bool {
-	if depth > maxDepth {
-		return false
+// A type parameter is zero-sized when every type in its type set is.
+func ZeroSized(typ types.Type) bool {
+	var s Sizer
+
+	return s.ZeroSized(typ)
+}
+
+// typeSetAll reports whether the type set of constraint is restricted to types satisfying pred.
+// This is the case when any embedded union or type, whose type sets are intersected, consists only of such types.
+// Constraints without type terms, like any or comparable, admit all types and report false.
+func typeSetAll(constraint types.Type, pred func(types.Type) bool) bool {
+	iface, ok := constraint.Underlying().(*types.Interface)
+	if !ok { // should not happen
+		return pred(constraint)
 	}
 
-	switch u := typ.Underlying().(type) {
-	case *types.Array:
-		if u.Len() > 0 {
-			return ZeroSized(u.Elem(), depth+1)
+	for i := range iface.NumEmbeddeds() {
+		if termsAll(iface.EmbeddedType(i), pred) {
+			return true
 		}
+	}
 
-		return true
+	return false
+}
 
-	case *types.Struct:
-		for field := range u.Fields() {
-			if !ZeroSized(field.Type(), depth+1) {
+// termsAll reports whether every type in the embedded element t satisfies pred.
+func termsAll(t types.Type, pred func(types.Type) bool) bool {
+	switch e := t.(type) {
+	case *types.Union:
+		for term := range e.Terms() {
+			if !termsAll(term.Type(), pred) {
 				return false
 			}
 		}
@@ -172,6 +204,10 @@ func ZeroSized(typ types.Type, depth int) bool {
 		return true
 
 	default:
-		return false
+		if types.IsInterface(e) {
+			return typeSetAll(e, pred)
+		}
+
+		return pred(e) // ~T has the same size as T
 	}
 }
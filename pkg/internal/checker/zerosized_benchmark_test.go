@@ -72,7 +72,7 @@ func BenchmarkIsZeroSized(b *testing.B) {
 			b.ReportAllocs()
 
 			for b.Loop() {
-				result = ZeroSized(typ, 0)
+				result = ZeroSized(typ)
 			}
 		})
 		b.Run(tc.name+"/SemiOptimized", func(b *testing.B) {
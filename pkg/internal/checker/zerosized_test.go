@@ -67,9 +67,32 @@ type ZSWithNonEmbeddedValueReceiver struct { F ZSWithValueReceiver }
 type ZSWithNonEmbeddedNonZero struct { F NonEmptyStruct }
 
 type ExcludableEmptyStruct struct{}
+
+type ZeroSizedConstraint interface{ ~struct{} | [0]int }
+type MixedConstraint interface{ EmptyStruct | NonEmptyStruct }
+type ValueMethodConstraint interface {
+	ZSWithValueReceiver
+	ValRec()
+}
+
+type GenericZS[T interface{ EmptyStruct | ArrayOfZero }] struct{}
+type GenericTilde[T ZeroSizedConstraint] struct{}
+type GenericIntersection[T interface{ MixedConstraint; EmptyStruct }] struct{}
+type GenericMixed[T MixedConstraint] struct{}
+type GenericAny[T any] struct{}
+type GenericComparable[T comparable] struct{}
+type GenericValueMethod[T ValueMethodConstraint] struct{}
+type GenericField[T ZeroSizedConstraint] struct{ _ T }
+type GenericExcludable[T interface{ EmptyStruct | ExcludableEmptyStruct }] struct{}
 `
 	pkg := parseSource(t, "test.go", src)
 
+	typeParam := func(name string) types.Type {
+		n, _ := getType(t, pkg, name).(*types.Named)
+
+		return n.TypeParams().At(0)
+	}
+
 	tests := [...]struct {
 		name             string
 		getTypeFn        func() types.Type
@@ -109,6 +132,21 @@ type ExcludableEmptyStruct struct{}
 
 		{name: "nil", getTypeFn: func() types.Type { return nil }, wantZeroSized: false},
 
+		{name: "TypeParam union", getTypeFn: func() types.Type { return typeParam("GenericZS") }, wantZeroSized: true, wantValueMethod: false, wantDetectedName: "testpkg.ArrayOfZero"},
+		{name: "TypeParam tilde", getTypeFn: func() types.Type { return typeParam("GenericTilde") }, wantZeroSized: true, wantValueMethod: false, wantDetectedName: "struct{}"},
+		{name: "TypeParam intersection", getTypeFn: func() types.Type { return typeParam("GenericIntersection") }, wantZeroSized: true, wantValueMethod: false},
+		{name: "TypeParam mixed", getTypeFn: func() types.Type { return typeParam("GenericMixed") }, wantZeroSized: false},
+		{name: "TypeParam any", getTypeFn: func() types.Type { return typeParam("GenericAny") }, wantZeroSized: false},
+		{name: "TypeParam comparable", getTypeFn: func() types.Type { return typeParam("GenericComparable") }, wantZeroSized: false},
+		{name: "TypeParam value method", getTypeFn: func() types.Type { return typeParam("GenericValueMethod") }, wantZeroSized: true, wantValueMethod: true},
+		{name: "TypeParam field", getTypeFn: func() types.Type { return getType(t, pkg, "GenericField") }, wantZeroSized: true, wantValueMethod: false, wantDetectedName: "testpkg.GenericField[T testpkg.ZeroSizedConstraint]"},
+		{
+			name:          "TypeParam with excluded term",
+			getTypeFn:     func() types.Type { return typeParam("GenericExcludable") },
+			setupChecker:  func(c *Checker) { c.Excludes.Add("testpkg.ExcludableEmptyStruct") },
+			wantZeroSized: false,
+		},
+
 		{
 			name:          "ExcludableEmptyStruct - excluded",
 			getTypeFn:     func() types.Type { return getType(t, pkg, "ExcludableEmptyStruct") },
@@ -160,6 +198,18 @@ type ExcludableEmptyStruct struct{}
 			},
 			wantZeroSized: false,
 		},
+		{
+			name: "Deep",
+			getTypeFn: func() types.Type {
+				var typ types.Type = types.NewStruct(nil, nil)
+				for range 50 {
+					typ = types.NewStruct([]*types.Var{types.NewVar(token.NoPos, nil, "_", types.NewArray(typ, 2))}, nil)
+				}
+
+				return typ
+			},
+			wantZeroSized: true, wantValueMethod: false,
+		},
 		{
 			name: "Big",
 			getTypeFn: func() types.Type {
@@ -332,3 +382,88 @@ type PtrToExcludableEmptyStruct *ExcludableEmptyStruct
 		})
 	}
 }
+
+// zeroSizes is a [types.Sizes] where every type is zero-sized.
+type zeroSizes struct{ types.Sizes }
+
+func (zeroSizes) Sizeof(types.Type) int64 { return 0 }
+
+// paddedSizes is a [types.Sizes] where every type has a size.
+type paddedSizes struct{ types.Sizes }
+
+func (paddedSizes) Sizeof(types.Type) int64 { return 1 }
+
+// listSizes is a [types.Sizes] that is not comparable.
+type listSizes struct {
+	types.Sizes
+	_ []int
+}
+
+func TestSizer_Sizes(t *testing.T) {
+	t.Parallel()
+
+	pointerStruct := types.NewStruct([]*types.Var{
+		types.NewVar(token.NoPos, nil, "_", types.NewPointer(types.Typ[types.Int])),
+	}, nil)
+
+	// struct{ _ T } with [T struct{}]
+	param := types.NewTypeParam(types.NewTypeName(token.NoPos, nil, "T", nil), nil)
+	param.SetConstraint(types.NewInterfaceType(nil, []types.Type{types.NewStruct(nil, nil)}))
+	paramStruct := types.NewStruct([]*types.Var{types.NewVar(token.NoPos, nil, "_", param)}, nil)
+
+	// struct{ _ [0]U } with [U any]
+	anyParam := types.NewTypeParam(types.NewTypeName(token.NoPos, nil, "U", nil), types.NewInterfaceType(nil, nil))
+	anyArrayStruct := types.NewStruct([]*types.Var{
+		types.NewVar(token.NoPos, nil, "_", types.NewArray(anyParam, 0)),
+	}, nil)
+
+	tests := [...]struct {
+		name  string
+		sizes types.Sizes
+		typ   types.Type
+		want  bool
+	}{
+		{name: "structural", sizes: nil, typ: pointerStruct, want: false},
+		{name: "amd64", sizes: types.SizesFor("gc", "amd64"), typ: pointerStruct, want: false},
+		{name: "amd64 empty", sizes: types.SizesFor("gc", "amd64"), typ: types.NewStruct(nil, nil), want: true},
+		{name: "zero sizes", sizes: zeroSizes{}, typ: pointerStruct, want: true},
+		{name: "invalid", sizes: zeroSizes{}, typ: types.Typ[types.Invalid], want: false},
+		{name: "padded empty", sizes: paddedSizes{}, typ: types.NewStruct(nil, nil), want: false},
+		{name: "padded array", sizes: paddedSizes{}, typ: types.NewArray(types.NewStruct(nil, nil), 2), want: false},
+		{name: "amd64 type parameter", sizes: types.SizesFor("gc", "amd64"), typ: paramStruct, want: true},
+		{name: "amd64 empty array", sizes: types.SizesFor("gc", "amd64"), typ: anyArrayStruct, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := Sizer{Sizes: tt.sizes}
+			if got := s.ZeroSized(tt.typ); got != tt.want {
+				t.Errorf("ZeroSized() = %v, want %v for type %s", got, tt.want, tt.typ)
+			}
+		})
+	}
+}
+
+func TestChecker_PrepareSizes(t *testing.T) {
+	t.Parallel()
+
+	empty := types.NewStruct(nil, nil)
+
+	var c Checker
+
+	for _, sizes := range []types.Sizes{listSizes{Sizes: zeroSizes{}}, listSizes{Sizes: paddedSizes{}}, nil} {
+		c.Sizes = sizes
+		c.Prepare() // Must not panic on sizes that are not comparable.
+
+		want := sizes == nil
+		if sizes, ok := sizes.(listSizes); ok {
+			_, want = sizes.Sizes.(zeroSizes)
+		}
+
+		if _, got := c.ZeroSizedType(empty); got != want {
+			t.Errorf("ZeroSizedType() = %v, want %v for sizes %T", got, want, sizes)
+		}
+	}
+}
@@ -18,6 +18,7 @@ package diag
 
 import (
 	"go/ast"
+	"go/token"
 	"go/types"
 
 	"golang.org/x/tools/go/analysis"
@@ -48,3 +49,19 @@ func (d *Diag) Prepare(pass *analysis.Pass) {
 func (d *Diag) TypesInfo() *types.Info {
 	return d.pass.TypesInfo
 }
+
+// Pkg returns the package of the current analysis pass.
+func (d *Diag) Pkg() *types.Package {
+	return d.pass.Pkg
+}
+
+// File returns the file of the current analysis pass containing pos, or nil if there is none.
+func (d *Diag) File(pos token.Pos) *ast.File {
+	for _, f := range d.pass.Files {
+		if f.FileStart <= pos && pos < f.FileEnd {
+			return f
+		}
+	}
+
+	return nil
+}
@@ -17,6 +17,7 @@
 package diag_test
 
 import (
+	"go/token"
 	"testing"
 )
 
@@ -29,3 +30,18 @@ func TestDiag_TypesInfo(t *testing.T) {
 		t.Errorf("TypesInfo() = %v, want %v", d.TypesInfo(), info)
 	}
 }
+
+func TestDiag_File(t *testing.T) {
+	t.Parallel()
+
+	info, pkg, fset, astFile := parseSource(t, "main.go", "package main")
+	d := newTestDiag(t, info, pkg, fset, astFile)
+
+	if f := d.File(astFile.Name.Pos()); f != astFile {
+		t.Errorf("File() = %v, want %v", f, astFile)
+	}
+
+	if f := d.File(token.NoPos); f != nil {
+		t.Errorf("File(NoPos) = %v, want nil", f)
+	}
+}
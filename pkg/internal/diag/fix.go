@@ -20,24 +20,23 @@ import (
 	"bytes"
 	"go/ast"
 	"go/format"
+	"go/token"
 	"go/types"
+	"strconv"
 
 	"golang.org/x/tools/go/analysis"
 )
 
 // ReplaceWithZeroValue generates a suggested fix to replace a pointer expression with its zero-value representation.
 func (d *Diag) ReplaceWithZeroValue(n ast.Node, t types.Type) []analysis.SuggestedFix {
-	switch t.(type) {
-	case *types.Named, *types.Alias, *types.Struct, *types.Array:
-		// supported types
-
-	default:
-		// types with non-zero sizes
+	if !hasZeroLiteral(t) {
 		return nil
 	}
 
 	q := Qualifier{
-		Pkg: d.pass.Pkg,
+		Pkg:   d.pass.Pkg,
+		Scope: d.pass.Pkg.Scope().Innermost(n.Pos()),
+		Pos:   n.Pos(),
 	}
 
 	if d.CurrentFile != nil {
@@ -47,43 +46,480 @@ func (d *Diag) ReplaceWithZeroValue(n ast.Node, t types.Type) []analysis.Suggest
 	var buf bytes.Buffer
 	types.WriteType(&buf, t, q.Qualifier)
 
+	buf.WriteString("{}")
+
+	fixes := suggestedFix(n, buf.Bytes(), "replace by zero value")
+
 	if q.NeedsImport {
-		return nil
+		edit, ok := d.addImports(d.CurrentFile, q.Added)
+		if !ok {
+			return nil
+		}
+
+		fixes[0].TextEdits = append([]analysis.TextEdit{edit}, fixes[0].TextEdits...)
 	}
 
+	return fixes
+}
+
+// Imports collects the imports to add to files of the current package.
+type Imports map[*ast.File][]*ast.ImportSpec
+
+// ZeroValue returns the zero value literal of t for use at pos, or false when t has no composite literal.
+// Imports needed in the file containing pos are recorded in imports, see [Diag.ImportEdits].
+func (d *Diag) ZeroValue(pos token.Pos, t types.Type, imports Imports) ([]byte, bool) {
+	f := d.File(pos)
+	if f == nil || !hasZeroLiteral(t) {
+		return nil, false
+	}
+
+	q := Qualifier{
+		Pkg:     d.pass.Pkg,
+		Imports: f.Imports,
+		Scope:   d.pass.Pkg.Scope().Innermost(pos),
+		Pos:     pos,
+		Added:   imports[f],
+	}
+
+	var buf bytes.Buffer
+	types.WriteType(&buf, t, q.Qualifier)
+
 	buf.WriteString("{}")
 
-	return suggestedFix(n, buf.Bytes(), "replace by zero value")
+	if q.NeedsImport {
+		imports[f] = q.Added
+	}
+
+	return buf.Bytes(), true
+}
+
+// ImportEdits returns the edits adding the collected imports.
+func (d *Diag) ImportEdits(imports Imports) ([]analysis.TextEdit, bool) {
+	edits := make([]analysis.TextEdit, 0, len(imports))
+	for f, specs := range imports {
+		edit, ok := d.addImports(f, specs)
+		if !ok {
+			return nil, false
+		}
+
+		edits = append(edits, edit)
+	}
+
+	return edits, true
+}
+
+// hasZeroLiteral reports whether the zero value of t can be written as a composite literal.
+func hasZeroLiteral(t types.Type) bool {
+	switch t.(type) {
+	case *types.Named, *types.Alias, *types.Struct, *types.Array:
+		return true
+
+	default: // types with non-zero sizes
+		return false
+	}
+}
+
+// addImports returns an edit adding the import specs to the file.
+func (d *Diag) addImports(f *ast.File, specs []*ast.ImportSpec) (analysis.TextEdit, bool) {
+	if f == nil {
+		return analysis.TextEdit{}, false
+	}
+
+	var decl *ast.GenDecl
+
+	for _, dl := range f.Decls {
+		if g, ok := dl.(*ast.GenDecl); ok && g.Tok == token.IMPORT {
+			decl = g // Use the last import declaration, the first might be `import "C"`.
+		}
+	}
+
+	var (
+		buf bytes.Buffer
+		pos token.Pos
+	)
+
+	switch {
+	case decl == nil: // No imports: insert after the package clause.
+		pos = f.Name.End()
+		for _, spec := range specs {
+			buf.WriteString("\n\nimport ")
+			writeImportSpec(&buf, spec)
+		}
+
+	case !decl.Lparen.IsValid(): // import "fmt"
+		pos = decl.End()
+		for _, spec := range specs {
+			buf.WriteString("\nimport ")
+			writeImportSpec(&buf, spec)
+		}
+
+	default: // import ( ... )
+		pos = decl.Rparen
+		if last := len(decl.Specs) - 1; last >= 0 &&
+			d.pass.Fset.Position(decl.Specs[last].End()).Line == d.pass.Fset.Position(decl.Rparen).Line {
+			pos = decl.Specs[last].End() // import ("fmt")
+		}
+
+		for _, spec := range specs {
+			if pos == decl.Rparen {
+				buf.WriteByte('\t')
+				writeImportSpec(&buf, spec)
+				buf.WriteByte('\n')
+			} else {
+				buf.WriteString("\n\t")
+				writeImportSpec(&buf, spec)
+			}
+		}
+	}
+
+	return analysis.TextEdit{Pos: pos, End: pos, NewText: buf.Bytes()}, true
+}
+
+// writeImportSpec writes an import spec without comments.
+func writeImportSpec(buf *bytes.Buffer, spec *ast.ImportSpec) {
+	if spec.Name != nil {
+		buf.WriteString(spec.Name.Name)
+		buf.WriteByte(' ')
+	}
+
+	buf.WriteString(spec.Path.Value)
 }
 
 // RemoveOp suggests a fix that removes an unary operator ('*' or '&') from an expression.
+// Only the operator is deleted, preserving the formatting and comments of x.
 func (d *Diag) RemoveOp(n ast.Node, x ast.Expr) []analysis.SuggestedFix {
-	var buf bytes.Buffer
-	if err := format.Node(&buf, d.pass.Fset, x); err != nil {
-		// should not happen
-		d.LogErrorf(n, "Unexpected error during remove formatting: %v", err)
-
-		return nil
+	edit := analysis.TextEdit{
+		Pos: n.Pos(),
+		End: x.Pos(),
 	}
 
-	return suggestedFix(n, buf.Bytes(), "remove operator")
+	return []analysis.SuggestedFix{
+		{
+			Message:   "remove operator",
+			TextEdits: []analysis.TextEdit{edit},
+		},
+	}
 }
 
 // MakePure suggests a fix that replaces expressions allocating or casting to a pointer
 // of a zero-sized type, such as (*T)(nil) or new(T), with a value literal T{}.
 // This promotes using zero-sized types directly by value rather than through pointers.
+// Only the text surrounding x is edited, preserving the formatting and comments of x.
 func (d *Diag) MakePure(n ast.Node, x ast.Expr) []analysis.SuggestedFix {
+	x = ast.Unparen(x)
+
+	edits := []analysis.TextEdit{
+		{
+			Pos: n.Pos(),
+			End: x.Pos(),
+		},
+		{
+			Pos:     x.End(),
+			End:     n.End(),
+			NewText: []byte("{}"),
+		},
+	}
+
+	return []analysis.SuggestedFix{
+		{
+			Message:   "change to pure type",
+			TextEdits: edits,
+		},
+	}
+}
+
+// ReplaceWithConstant suggests a fix that replaces an expression with its constant boolean result.
+func (d *Diag) ReplaceWithConstant(n ast.Node, value bool) []analysis.SuggestedFix {
+	return suggestedFix(n, []byte(strconv.FormatBool(value)), "replace with constant result")
+}
+
+// AssertType suggests a fix that replaces a method body with a type assertion of x to typ,
+// returning whether the assertion holds. This is used for `Is(error) bool` methods.
+func (d *Diag) AssertType(body *ast.BlockStmt, x, typ ast.Expr) []analysis.SuggestedFix {
 	var buf bytes.Buffer
+
+	buf.WriteString("{\n\t_, ok := ")
+
 	if err := format.Node(&buf, d.pass.Fset, x); err != nil {
 		// should not happen
-		d.LogErrorf(n, "Unexpected error during pure formatting: %v", err)
+		d.LogErrorf(body, "Unexpected error during assertion formatting: %v", err)
 
 		return nil
 	}
 
-	buf.WriteString("{}")
+	buf.WriteString(".(")
+
+	if err := format.Node(&buf, d.pass.Fset, typ); err != nil {
+		// should not happen
+		d.LogErrorf(body, "Unexpected error during assertion formatting: %v", err)
+
+		return nil
+	}
+
+	buf.WriteString(")\n\n\treturn ok\n}")
 
-	return suggestedFix(n, buf.Bytes(), "change to pure type")
+	return suggestedFix(body, buf.Bytes(), "use type assertion")
+}
+
+// MoveToFront suggests a fix that moves the last field of a struct, together with its comments, to the front.
+// The moved field keeps the indentation of the first field.
+func (d *Diag) MoveToFront(fields *ast.FieldList) []analysis.SuggestedFix {
+	n := len(fields.List)
+	if n < 2 {
+		return nil
+	}
+
+	first, prev, last := fields.List[0], fields.List[n-2], fields.List[n-1]
+
+	pos := fieldStart(first)
+
+	separator := []byte("; ")
+	if d.pass.Fset.Position(fields.Opening).Line != d.pass.Fset.Position(fields.Closing).Line {
+		indent, ok := d.indentation(pos)
+		if !ok {
+			return nil
+		}
+
+		separator = append([]byte{'\n'}, indent...)
+	}
+
+	var buf bytes.Buffer
+	if last.Doc != nil {
+		for _, c := range last.Doc.List {
+			buf.WriteString(c.Text)
+			buf.Write(separator)
+		}
+	}
+
+	for i, name := range last.Names {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+
+		buf.WriteString(name.Name)
+	}
+
+	if len(last.Names) > 0 {
+		buf.WriteByte(' ')
+	}
+
+	if err := format.Node(&buf, d.pass.Fset, last.Type); err != nil {
+		// should not happen
+		d.LogErrorf(last, "Unexpected error during move formatting: %v", err)
+
+		return nil
+	}
+
+	if last.Tag != nil {
+		buf.WriteByte(' ')
+		buf.WriteString(last.Tag.Value)
+	}
+
+	end := last.End()
+	if last.Comment != nil {
+		for _, c := range last.Comment.List {
+			buf.WriteByte(' ')
+			buf.WriteString(c.Text)
+		}
+
+		end = last.Comment.End()
+	}
+
+	buf.Write(separator)
+
+	start := prev.End()
+	if prev.Comment != nil {
+		start = prev.Comment.End()
+	}
+
+	return []analysis.SuggestedFix{
+		{
+			Message: "move field to front",
+			TextEdits: []analysis.TextEdit{
+				{Pos: pos, End: pos, NewText: buf.Bytes()},
+				{Pos: start, End: end, NewText: nil},
+			},
+		},
+	}
+}
+
+// BoolFlag describes the declaration and uses of a pointer that is only used as a boolean flag.
+type BoolFlag struct {
+	Type     ast.Expr          // Declared type, if any.
+	Set      []ast.Expr        // Values setting the flag, like &T{} or new(T).
+	Clear    []ast.Expr        // Values clearing the flag (nil).
+	Compares []*ast.BinaryExpr // Comparisons with nil.
+}
+
+// UseBool suggests a fix that changes a pointer used as a flag to bool: The declared type is replaced by bool,
+// values by true or false and comparisons with nil by the operand or its negation.
+func (d *Diag) UseBool(flag BoolFlag) []analysis.SuggestedFix {
+	var edits []analysis.TextEdit
+
+	if flag.Type != nil {
+		edits = append(edits, analysis.TextEdit{Pos: flag.Type.Pos(), End: flag.Type.End(), NewText: []byte("bool")})
+	}
+
+	for _, x := range flag.Set {
+		edits = append(edits, analysis.TextEdit{Pos: x.Pos(), End: x.End(), NewText: []byte("true")})
+	}
+
+	for _, x := range flag.Clear {
+		edits = append(edits, analysis.TextEdit{Pos: x.Pos(), End: x.End(), NewText: []byte("false")})
+	}
+
+	for _, c := range flag.Compares {
+		var not []byte
+		if c.Op == token.EQL {
+			not = []byte("!")
+		}
+
+		if d.pass.TypesInfo.Types[c.X].IsNil() { // nil == x
+			edits = append(edits, analysis.TextEdit{Pos: c.Pos(), End: c.Y.Pos(), NewText: not})
+
+			continue
+		}
+
+		// x == nil
+		if not != nil {
+			edits = append(edits, analysis.TextEdit{Pos: c.Pos(), End: c.Pos(), NewText: not})
+		}
+
+		edits = append(edits, analysis.TextEdit{Pos: c.X.End(), End: c.End(), NewText: nil})
+	}
+
+	return []analysis.SuggestedFix{
+		{
+			Message:   "use bool",
+			TextEdits: edits,
+		},
+	}
+}
+
+// AddField suggests a fix that makes a zero-sized struct type non-zero-sized by adding a `_ int` field
+// as the first field. When withIs is true, it also adds an `Is` method on the pointer receiver,
+// so that `errors.Is` matches any error of this type, as it did when pointers to the type compared equal.
+// In multi-line structs, the field gets the indentation of the first field.
+func (d *Diag) AddField(decl *ast.GenDecl, spec *ast.TypeSpec, withIs bool) []analysis.SuggestedFix {
+	st, ok := spec.Type.(*ast.StructType)
+	if !ok || spec.TypeParams != nil {
+		return nil
+	}
+
+	fields := st.Fields
+
+	var edit analysis.TextEdit
+
+	switch {
+	case len(fields.List) == 0:
+		edit = analysis.TextEdit{Pos: fields.Opening + 1, End: fields.Closing, NewText: []byte(" _ int ")}
+
+	case d.pass.Fset.Position(fields.Opening).Line != d.pass.Fset.Position(fields.Closing).Line:
+		pos := fieldStart(fields.List[0])
+
+		indent, ok := d.indentation(pos)
+		if !ok {
+			return nil
+		}
+
+		edit = analysis.TextEdit{Pos: pos, End: pos, NewText: append([]byte("_ int\n"), indent...)}
+
+	default:
+		pos := fieldStart(fields.List[0])
+		edit = analysis.TextEdit{Pos: pos, End: pos, NewText: []byte("_ int; ")}
+	}
+
+	if !withIs {
+		return []analysis.SuggestedFix{
+			{
+				Message:   "add `_ int` field",
+				TextEdits: []analysis.TextEdit{edit},
+			},
+		}
+	}
+
+	name := spec.Name.Name
+	method := "\n\nfunc (*" + name + ") Is(err error) bool {\n\t_, ok := err.(*" + name + ")\n\n\treturn ok\n}"
+
+	return []analysis.SuggestedFix{
+		{
+			Message: "add `_ int` field and `Is` method",
+			TextEdits: []analysis.TextEdit{
+				edit,
+				{Pos: decl.End(), End: decl.End(), NewText: []byte(method)},
+			},
+		},
+	}
+}
+
+// fieldStart returns the start of a field including its doc comment.
+func fieldStart(field *ast.Field) token.Pos {
+	if field.Doc != nil {
+		return field.Doc.Pos()
+	}
+
+	return field.Pos()
+}
+
+// ExcludeType suggests a fix that adds a `//zerolint:exclude` directive to a type declaration.
+// Since the directive applies to the whole declaration, grouped declarations are not supported.
+func (d *Diag) ExcludeType(decl *ast.GenDecl) []analysis.SuggestedFix {
+	if len(decl.Specs) != 1 {
+		return nil
+	}
+
+	edit := analysis.TextEdit{Pos: decl.Pos(), End: decl.Pos(), NewText: []byte("//zerolint:exclude\n")}
+
+	return []analysis.SuggestedFix{
+		{
+			Message:   "exclude type via //zerolint:exclude",
+			TextEdits: []analysis.TextEdit{edit},
+		},
+	}
+}
+
+// NewErrors suggests a fix that replaces the values of sentinel error variables with distinct errors
+// created by errors.New(text).
+func (d *Diag) NewErrors(values []ast.Expr, text string) []analysis.SuggestedFix {
+	errorsPkg := types.NewPackage("errors", "errors")
+
+	imports := make(Imports)
+	edits := make([]analysis.TextEdit, 0, len(values)+1)
+
+	for _, x := range values {
+		f := d.File(x.Pos())
+		if f == nil {
+			return nil
+		}
+
+		q := Qualifier{
+			Pkg:     d.pass.Pkg,
+			Imports: f.Imports,
+			Scope:   d.pass.Pkg.Scope().Innermost(x.Pos()),
+			Pos:     x.Pos(),
+			Added:   imports[f],
+		}
+
+		newText := q.Qualifier(errorsPkg) + ".New(" + strconv.Quote(text) + ")"
+
+		if q.NeedsImport {
+			imports[f] = q.Added
+		}
+
+		edits = append(edits, analysis.TextEdit{Pos: x.Pos(), End: x.End(), NewText: []byte(newText)})
+	}
+
+	importEdits, ok := d.ImportEdits(imports)
+	if !ok {
+		return nil
+	}
+
+	return []analysis.SuggestedFix{
+		{
+			Message:   "use errors.New for distinct sentinel errors",
+			TextEdits: append(edits, importEdits...),
+		},
+	}
 }
 
 // suggestedFix returns a slice of SuggestedFix containing a single fix with the specified message and text edit.
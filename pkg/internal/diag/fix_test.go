@@ -18,13 +18,17 @@
 package diag_test
 
 import (
+	"cmp"
 	"go/ast"
 	"go/token"
 	"go/types"
+	"slices"
 	"strings"
 	"testing"
 
 	"golang.org/x/tools/go/analysis"
+
+	. "fillmore-labs.com/zerolint/pkg/internal/diag"
 )
 
 func TestDiag_ReplaceWithZeroValue(t *testing.T) { //nolint:funlen
@@ -35,6 +39,7 @@ func TestDiag_ReplaceWithZeroValue(t *testing.T) { //nolint:funlen
 		src             string
 		findNodeAndType func(f *ast.File, pkg *types.Package, info *types.Info) (ast.Node, types.Type)
 		expectedNewText string
+		expectedImport  string
 		expectNilFix    bool
 	}{
 		{
@@ -76,7 +81,24 @@ func TestDiag_ReplaceWithZeroValue(t *testing.T) { //nolint:funlen
 
 				return nodeToReplace, namedType
 			},
-			expectNilFix: true, // Should be nil because the import is missing.
+			expectedNewText: "other.OtherStruct{}",
+			expectedImport:  "\n\nimport \"example.com/other\"",
+		},
+		{
+			name: "type from package with shadowed name",
+			src:  "package testpkg\nimport \"go/token\"\nvar _ token.Pos\nfunc f(token int) any { return token }",
+			findNodeAndType: func(f *ast.File, _ *types.Package, _ *types.Info) (ast.Node, types.Type) {
+				funcDecl := f.Decls[2].(*ast.FuncDecl)                              // func f(token int) any
+				nodeToReplace := funcDecl.Body.List[0].(*ast.ReturnStmt).Results[0] // token
+
+				tokenPkg := types.NewPackage("go/token", "token")
+				typeName := types.NewTypeName(token.NoPos, tokenPkg, "Empty", nil)
+				namedType := types.NewNamed(typeName, types.NewStruct(nil, nil), nil)
+
+				return nodeToReplace, namedType
+			},
+			expectedNewText: "token1.Empty{}",
+			expectedImport:  "\nimport token1 \"go/token\"",
 		},
 		{
 			name: "unsupported type (pointer) for zero value literal",
@@ -102,19 +124,79 @@ func TestDiag_ReplaceWithZeroValue(t *testing.T) { //nolint:funlen
 			nodeToReplace, typeOfZeroValue := tt.findNodeAndType(astFile, pkg, info)
 
 			fixes := d.ReplaceWithZeroValue(nodeToReplace, typeOfZeroValue)
-			assertFix(t, fixes, tt.expectNilFix, "replace by zero value", tt.expectedNewText)
+			if tt.expectedImport == "" {
+				assertFix(t, fixes, tt.expectNilFix, "replace by zero value", tt.expectedNewText)
+
+				return
+			}
+
+			if len(fixes) != 1 || len(fixes[0].TextEdits) != 2 {
+				t.Fatalf("expected 1 fix with 2 edits, got %+v", fixes)
+			}
+
+			if got := string(fixes[0].TextEdits[0].NewText); got != tt.expectedImport {
+				t.Errorf("import edit.NewText = %q, want %q", got, tt.expectedImport)
+			}
+
+			if got := string(fixes[0].TextEdits[1].NewText); got != tt.expectedNewText {
+				t.Errorf("edit.NewText = %q, want %q", got, tt.expectedNewText)
+			}
 		})
 	}
 }
 
+func TestDiag_ZeroValue(t *testing.T) {
+	t.Parallel()
+
+	const src = "package testpkg\ntype MyStruct struct{}\nvar _, _ *MyStruct = nil, nil"
+
+	info, pkg, fset, astFile := parseSource(t, "test.go", src)
+	d := newTestDiag(t, info, pkg, fset, astFile)
+	values := astFile.Decls[1].(*ast.GenDecl).Specs[0].(*ast.ValueSpec).Values
+
+	otherPkg := types.NewPackage("example.com/other", "other")
+	typeName := types.NewTypeName(token.NoPos, otherPkg, "OtherStruct", nil)
+	other := types.NewNamed(typeName, types.NewStruct(nil, nil), nil)
+
+	imports := make(Imports)
+
+	for _, tt := range [...]struct {
+		value ast.Expr
+		typ   types.Type
+		want  string
+	}{
+		{values[0], getType(t, pkg, "MyStruct"), "MyStruct{}"},
+		{values[0], other, "other.OtherStruct{}"},
+		{values[1], other, "other.OtherStruct{}"}, // Import added only once.
+	} {
+		got, ok := d.ZeroValue(tt.value.Pos(), tt.typ, imports)
+		if !ok || string(got) != tt.want {
+			t.Errorf("ZeroValue() = %q, %t, want %q", got, ok, tt.want)
+		}
+	}
+
+	if _, ok := d.ZeroValue(values[0].Pos(), types.NewPointer(other), imports); ok {
+		t.Error("ZeroValue() of pointer succeeded, want false")
+	}
+
+	edits, ok := d.ImportEdits(imports)
+	if !ok || len(edits) != 1 {
+		t.Fatalf("ImportEdits() = %v, %t, want 1 edit", edits, ok)
+	}
+
+	if got, want := string(edits[0].NewText), "\n\nimport \"example.com/other\""; got != want {
+		t.Errorf("ImportEdits() = %q, want %q", got, want)
+	}
+}
+
 func TestDiag_RemoveOp(t *testing.T) {
 	t.Parallel()
 
 	tests := [...]struct {
-		name            string
-		src             string
-		findNodes       func(f *ast.File, info *types.Info) (ast.Node, ast.Expr) // n, x
-		expectedNewText string
+		name      string
+		src       string
+		findNodes func(f *ast.File, info *types.Info) (ast.Node, ast.Expr) // n, x
+		want      string
 	}{
 		{
 			name: "remove star",
@@ -126,7 +208,7 @@ func TestDiag_RemoveOp(t *testing.T) {
 
 				return starExprN, identX
 			},
-			expectedNewText: "pi",
+			want: "package testpkg\nvar i int\nvar pi = &i\nvar _ = pi",
 		},
 		{
 			name: "remove ampersand",
@@ -138,7 +220,19 @@ func TestDiag_RemoveOp(t *testing.T) {
 
 				return unaryExprN, identX
 			},
-			expectedNewText: "i",
+			want: "package testpkg\nvar i int\nvar _ = i",
+		},
+		{
+			name: "preserve comments",
+			src:  "package testpkg\ntype S struct{ a, b int }\nvar _ = &S{\n\ta: 1, // first\n\tb: 2,\n}",
+			findNodes: func(f *ast.File, _ *types.Info) (ast.Node, ast.Expr) {
+				valSpec := f.Decls[1].(*ast.GenDecl).Specs[0].(*ast.ValueSpec)
+				unaryExprN := valSpec.Values[0].(*ast.UnaryExpr) // &S{...}
+				compositeX := unaryExprN.X                       // S{...}
+
+				return unaryExprN, compositeX
+			},
+			want: "package testpkg\ntype S struct{ a, b int }\nvar _ = S{\n\ta: 1, // first\n\tb: 2,\n}",
 		},
 	}
 
@@ -151,7 +245,7 @@ func TestDiag_RemoveOp(t *testing.T) {
 			nodeN, exprX := tt.findNodes(astFile, info)
 
 			fixes := d.RemoveOp(nodeN, exprX)
-			assertFix(t, fixes, false, "remove operator", tt.expectedNewText)
+			assertApplied(t, fset, tt.src, fixes, "remove operator", tt.want)
 		})
 	}
 }
@@ -160,10 +254,10 @@ func TestDiag_MakePure(t *testing.T) {
 	t.Parallel()
 
 	tests := [...]struct {
-		name            string
-		src             string
-		findNodes       func(f *ast.File, info *types.Info) (ast.Node, ast.Expr) // n, x (type expr)
-		expectedNewText string
+		name      string
+		src       string
+		findNodes func(f *ast.File, info *types.Info) (ast.Node, ast.Expr) // n, x (type expr)
+		want      string
 	}{
 		{
 			name: "new(T)",
@@ -175,7 +269,7 @@ func TestDiag_MakePure(t *testing.T) {
 
 				return callExprN, typeExprX
 			},
-			expectedNewText: "S{}",
+			want: "package testpkg\ntype S struct{}\nvar _ = S{}",
 		},
 		{
 			name: "(*T)(nil)",
@@ -189,7 +283,19 @@ func TestDiag_MakePure(t *testing.T) {
 
 				return callExprN, typeExprX
 			},
-			expectedNewText: "S{}",
+			want: "package testpkg\ntype S struct{}\nvar _ = S{}",
+		},
+		{
+			name: "new((T)) with comments",
+			src:  "package testpkg\nvar _ = new((struct {\n\t_ [0]int // padding\n}))",
+			findNodes: func(f *ast.File, _ *types.Info) (ast.Node, ast.Expr) {
+				valSpec := f.Decls[0].(*ast.GenDecl).Specs[0].(*ast.ValueSpec)
+				callExprN := valSpec.Values[0].(*ast.CallExpr) // new((struct{...}))
+				typeExprX := callExprN.Args[0]                 // (struct{...})
+
+				return callExprN, typeExprX
+			},
+			want: "package testpkg\nvar _ = struct {\n\t_ [0]int // padding\n}{}",
 		},
 	}
 
@@ -203,11 +309,133 @@ func TestDiag_MakePure(t *testing.T) {
 			nodeN, exprX := tt.findNodes(astFile, info)
 
 			fixes := d.MakePure(nodeN, exprX)
-			assertFix(t, fixes, false, "change to pure type", tt.expectedNewText)
+			assertApplied(t, fset, tt.src, fixes, "change to pure type", tt.want)
+		})
+	}
+}
+
+func TestDiag_ReplaceWithConstant(t *testing.T) {
+	t.Parallel()
+
+	src := "package testpkg\ntype S struct{}\nvar a, b S\nvar _ = a != b"
+
+	info, pkg, fset, astFile := parseSource(t, "test.go", src)
+	d := newTestDiag(t, info, pkg, fset, astFile)
+
+	valSpec := astFile.Decls[2].(*ast.GenDecl).Specs[0].(*ast.ValueSpec)
+	binExpr := valSpec.Values[0] // a != b
+
+	fixes := d.ReplaceWithConstant(binExpr, false)
+	assertFix(t, fixes, false, "replace with constant result", "false")
+}
+
+func TestDiag_AssertType(t *testing.T) {
+	t.Parallel()
+
+	src := "package testpkg\ntype E struct{}\nfunc (*E) Error() string { return \"\" }\nfunc (e *E) Is(target error) bool { return target == e }"
+
+	info, pkg, fset, astFile := parseSource(t, "test.go", src)
+	d := newTestDiag(t, info, pkg, fset, astFile)
+
+	funcDecl := astFile.Decls[2].(*ast.FuncDecl)    // func (e *E) Is(...)
+	paramX := funcDecl.Type.Params.List[0].Names[0] // target
+	recvType := funcDecl.Recv.List[0].Type          // *E
+
+	fixes := d.AssertType(funcDecl.Body, paramX, recvType)
+	assertFix(t, fixes, false, "use type assertion", "{\n\t_, ok := target.(*E)\n\n\treturn ok\n}")
+}
+
+func TestDiag_MoveToFront(t *testing.T) {
+	t.Parallel()
+
+	tests := [...]struct {
+		name string
+		src  string
+		want string
+	}{
+		{
+			name: "multiple lines",
+			src:  "package testpkg\ntype S struct {\n\tx int // x\n\t_ struct{} `tag:\"\"`\n}",
+			want: "package testpkg\ntype S struct {\n\t_ struct{} `tag:\"\"`\n\tx int // x\n}",
+		},
+		{
+			name: "indented with spaces",
+			src:  "package testpkg\ntype S struct {\n    // x\n    x int\n    // y\n    _ struct{}\n}",
+			want: "package testpkg\ntype S struct {\n    // y\n    _ struct{}\n    // x\n    x int\n}",
+		},
+		{
+			name: "documented",
+			src:  "package testpkg\ntype S struct {\n\tx int32\n\t// Marker.\n\t_ struct{}\n}",
+			want: "package testpkg\ntype S struct {\n\t// Marker.\n\t_ struct{}\n\tx int32\n}",
+		},
+		{
+			name: "single line",
+			src:  "package testpkg\ntype S struct{ x int; _ struct{} }",
+			want: "package testpkg\ntype S struct{ _ struct{}; x int }",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			d, fset, astFile := newSourceDiag(t, tt.src)
+			fields := astFile.Decls[0].(*ast.GenDecl).Specs[0].(*ast.TypeSpec).Type.(*ast.StructType).Fields
+
+			fixes := d.MoveToFront(fields)
+			assertApplied(t, fset, tt.src, fixes, "move field to front", tt.want)
 		})
 	}
 }
 
+func TestDiag_MoveToFront_single(t *testing.T) {
+	t.Parallel()
+
+	d, _, astFile := newSourceDiag(t, "package testpkg\ntype T struct{ x int }")
+	fields := astFile.Decls[0].(*ast.GenDecl).Specs[0].(*ast.TypeSpec).Type.(*ast.StructType).Fields
+
+	if fixes := d.MoveToFront(fields); fixes != nil {
+		t.Errorf("expected nil fixes for single field, got %+v", fixes)
+	}
+}
+
+func TestDiag_UseBool(t *testing.T) {
+	t.Parallel()
+
+	src := "package testpkg\ntype T struct{}\nvar x *T = &T{}\nvar _ = x == nil\nvar _ = nil != x\nfunc f() { x = nil }"
+
+	info, pkg, fset, astFile := parseSource(t, "test.go", src)
+	d := newTestDiag(t, info, pkg, fset, astFile)
+
+	spec := astFile.Decls[1].(*ast.GenDecl).Specs[0].(*ast.ValueSpec)
+	eql := astFile.Decls[2].(*ast.GenDecl).Specs[0].(*ast.ValueSpec).Values[0].(*ast.BinaryExpr)
+	neq := astFile.Decls[3].(*ast.GenDecl).Specs[0].(*ast.ValueSpec).Values[0].(*ast.BinaryExpr)
+	clearNil := astFile.Decls[4].(*ast.FuncDecl).Body.List[0].(*ast.AssignStmt).Rhs[0]
+
+	fixes := d.UseBool(BoolFlag{
+		Type:     spec.Type,
+		Set:      spec.Values,
+		Clear:    []ast.Expr{clearNil},
+		Compares: []*ast.BinaryExpr{eql, neq},
+	})
+	if len(fixes) != 1 {
+		t.Fatalf("expected 1 fix, got %d", len(fixes))
+	}
+
+	want := []string{"bool", "true", "false", "!", "", ""}
+
+	edits := fixes[0].TextEdits
+	if len(edits) != len(want) {
+		t.Fatalf("expected %d edits, got %+v", len(want), edits)
+	}
+
+	for i, edit := range edits {
+		if got := string(edit.NewText); got != want[i] {
+			t.Errorf("edit %d NewText = %q, want %q", i, got, want[i])
+		}
+	}
+}
+
 func assertFix(t *testing.T, fixes []analysis.SuggestedFix, expectNil bool, expectedMsg, expectedNewText string) {
 	t.Helper()
 
@@ -240,3 +468,120 @@ func assertFix(t *testing.T, fixes []analysis.SuggestedFix, expectNil bool, expe
 		t.Errorf("edit.NewText = %q, want %q", string(edit.NewText), expectedNewText)
 	}
 }
+
+func TestDiag_AddField(t *testing.T) {
+	t.Parallel()
+
+	tests := [...]struct {
+		name    string
+		src     string
+		withIs  bool
+		message string
+		want    string
+	}{
+		{
+			name:    "empty struct",
+			src:     "package testpkg\ntype S struct{}",
+			message: "add `_ int` field",
+			want:    "package testpkg\ntype S struct{ _ int }",
+		},
+		{
+			name:    "single line",
+			src:     "package testpkg\ntype S struct{ _ [0]int }",
+			message: "add `_ int` field",
+			want:    "package testpkg\ntype S struct{ _ int; _ [0]int }",
+		},
+		{
+			name:    "multiple lines",
+			src:     "package testpkg\ntype S struct {\n\t_ [0]int\n}",
+			message: "add `_ int` field",
+			want:    "package testpkg\ntype S struct {\n\t_ int\n\t_ [0]int\n}",
+		},
+		{
+			name:    "indented with spaces",
+			src:     "package testpkg\ntype S struct {\n    // Doc.\n    _ [0]int\n}",
+			message: "add `_ int` field",
+			want:    "package testpkg\ntype S struct {\n    _ int\n    // Doc.\n    _ [0]int\n}",
+		},
+		{
+			name:    "with Is method",
+			src:     "package testpkg\ntype E struct{}",
+			withIs:  true,
+			message: "add `_ int` field and `Is` method",
+			want: "package testpkg\ntype E struct{ _ int }\n\n" +
+				"func (*E) Is(err error) bool {\n\t_, ok := err.(*E)\n\n\treturn ok\n}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			d, fset, astFile := newSourceDiag(t, tt.src)
+
+			decl := astFile.Decls[0].(*ast.GenDecl)
+			spec := decl.Specs[0].(*ast.TypeSpec)
+
+			fixes := d.AddField(decl, spec, tt.withIs)
+			assertApplied(t, fset, tt.src, fixes, tt.message, tt.want)
+		})
+	}
+}
+
+func TestDiag_AddFieldWithoutSource(t *testing.T) {
+	t.Parallel()
+
+	src := "package testpkg\ntype S struct {\n\t_ [0]int\n}"
+
+	info, pkg, fset, astFile := parseSource(t, "test.go", src)
+	d := newTestDiag(t, info, pkg, fset, astFile)
+
+	decl := astFile.Decls[0].(*ast.GenDecl)
+	if fixes := d.AddField(decl, decl.Specs[0].(*ast.TypeSpec), false); fixes != nil {
+		t.Errorf("expected nil fixes without source, got %+v", fixes)
+	}
+}
+
+func TestDiag_ExcludeType(t *testing.T) {
+	t.Parallel()
+
+	src := "package testpkg\n// S is a marker.\ntype S struct{}\ntype (\n\tA struct{}\n\tB struct{}\n)"
+
+	info, pkg, fset, astFile := parseSource(t, "test.go", src)
+	d := newTestDiag(t, info, pkg, fset, astFile)
+
+	fixes := d.ExcludeType(astFile.Decls[0].(*ast.GenDecl))
+	assertApplied(t, fset, src, fixes, "exclude type via //zerolint:exclude",
+		"package testpkg\n// S is a marker.\n//zerolint:exclude\ntype S struct{}\ntype (\n\tA struct{}\n\tB struct{}\n)")
+
+	if fixes := d.ExcludeType(astFile.Decls[1].(*ast.GenDecl)); fixes != nil {
+		t.Errorf("expected nil fixes for grouped declaration, got %+v", fixes)
+	}
+}
+
+// assertApplied checks that applying the edits of a single fix to src results in want.
+func assertApplied(t *testing.T, fset *token.FileSet, src string, fixes []analysis.SuggestedFix, expectedMsg, want string) {
+	t.Helper()
+
+	if len(fixes) != 1 {
+		t.Fatalf("expected 1 fix, got %d", len(fixes))
+	}
+
+	fix := fixes[0]
+	if !strings.Contains(fix.Message, expectedMsg) {
+		t.Errorf("fix.Message = %q, want to contain %q", fix.Message, expectedMsg)
+	}
+
+	edits := slices.Clone(fix.TextEdits)
+	slices.SortFunc(edits, func(a, b analysis.TextEdit) int { return cmp.Compare(b.Pos, a.Pos) }) // Apply back to front.
+
+	got := src
+	for _, edit := range edits {
+		start, end := fset.Position(edit.Pos).Offset, fset.Position(edit.End).Offset
+		got = got[:start] + string(edit.NewText) + got[end:]
+	}
+
+	if got != want {
+		t.Errorf("applied fix = %q, want %q", got, want)
+	}
+}
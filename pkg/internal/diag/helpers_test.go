@@ -50,6 +50,25 @@ func newTestDiag(tb testing.TB,
 	return c
 }
 
+// newSourceDiag parses src and returns a [Diag] that can read the source, like during analysis.
+func newSourceDiag(tb testing.TB, src string) (*Diag, *token.FileSet, *ast.File) {
+	tb.Helper()
+
+	info, pkg, fset, astFile := parseSource(tb, "test.go", src)
+
+	d := New(&analysis.Pass{
+		Pkg:       pkg,
+		TypesInfo: info,
+		Fset:      fset,
+		Files:     []*ast.File{astFile},
+		Report:    func(analysis.Diagnostic) {},
+		ReadFile:  func(string) ([]byte, error) { return []byte(src), nil },
+	})
+	d.CurrentFile = astFile
+
+	return d, fset, astFile
+}
+
 // parseSource is a helper to parse source and get [types.Info] and [types.Package].
 func parseSource(tb testing.TB, filename, src string) (*types.Info, *types.Package, *token.FileSet, *ast.File) {
 	tb.Helper()
@@ -57,7 +76,7 @@ func parseSource(tb testing.TB, filename, src string) (*types.Info, *types.Packa
 	fset := token.NewFileSet()
 	fset.AddFile(filename, -1, len(src))
 
-	f, err := parser.ParseFile(fset, filename, src, parser.SkipObjectResolution)
+	f, err := parser.ParseFile(fset, filename, src, parser.ParseComments|parser.SkipObjectResolution)
 	if err != nil {
 		tb.Fatalf("failed to parse source: %T %v", err, err)
 	}
@@ -18,39 +18,128 @@ package diag
 
 import (
 	"go/ast"
+	"go/token"
 	"go/types"
+	"path"
 	"strconv"
 )
 
 // Qualifier holds the current package and imports for [Qualifier.Qualifier].
 type Qualifier struct {
-	Pkg         *types.Package
-	Imports     []*ast.ImportSpec
+	Pkg     *types.Package
+	Imports []*ast.ImportSpec
+
+	// Scope at Pos, used to detect shadowed package names. Optional.
+	Scope *types.Scope
+	Pos   token.Pos
+
 	NeedsImport bool
+	Added       []*ast.ImportSpec // Imports that need to be added for the returned qualifiers.
 }
 
 // Qualifier returns the package name or alias to use when referring to the given package
 // in the context of the currently analyzed file (whose imports are in q.Imports).
+//
+// When the package is not imported or its name is shadowed, it picks a non-conflicting name
+// and records the import in q.Added.
 func (q *Qualifier) Qualifier(pkg *types.Package) string {
 	if pkg == nil || pkg == q.Pkg {
 		return ""
 	}
 
-	for _, i := range q.Imports {
+	for _, i := range q.Added {
 		if qualifier, ok := importName(i, pkg); ok {
 			return qualifier
 		}
 	}
 
+	for _, i := range q.Imports {
+		if qualifier, ok := importName(i, pkg); ok && !q.shadowed(qualifier, pkg) {
+			return qualifier
+		}
+	}
+
 	q.NeedsImport = true
 
-	// Not imported - default to quoted path, breaking the build in an informative way.
-	return strconv.Quote(pkg.Path())
+	name := q.freeName(pkg.Name())
+
+	spec := &ast.ImportSpec{Path: &ast.BasicLit{Kind: token.STRING, Value: strconv.Quote(pkg.Path())}}
+	if name != pkg.Name() {
+		spec.Name = ast.NewIdent(name)
+	}
+
+	q.Added = append(q.Added, spec)
+
+	return name
+}
+
+// shadowed checks whether the qualifier does not refer to the imported package at q.Pos.
+func (q *Qualifier) shadowed(qualifier string, pkg *types.Package) bool {
+	if q.Scope == nil || qualifier == "" {
+		return false
+	}
+
+	_, obj := q.Scope.LookupParent(qualifier, q.Pos)
+	pkgName, ok := obj.(*types.PkgName)
+
+	return !ok || pkgName.Imported().Path() != pkg.Path()
+}
+
+// freeName returns name or, if that is already taken, name with the smallest numeric suffix that is not.
+func (q *Qualifier) freeName(name string) string {
+	for i := 0; ; i++ {
+		candidate := name
+		if i > 0 {
+			candidate += strconv.Itoa(i)
+		}
+
+		if !q.taken(candidate) {
+			return candidate
+		}
+	}
+}
+
+// taken checks whether name is already declared at package level, in the file or in scope at q.Pos.
+func (q *Qualifier) taken(name string) bool {
+	for _, imports := range [...][]*ast.ImportSpec{q.Imports, q.Added} {
+		for _, i := range imports {
+			if importedName(i) == name {
+				return true
+			}
+		}
+	}
+
+	if q.Pkg != nil && q.Pkg.Scope().Lookup(name) != nil {
+		return true
+	}
+
+	if q.Scope != nil {
+		if _, obj := q.Scope.LookupParent(name, q.Pos); obj != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// importedName returns the name an import spec declares in the file scope,
+// approximating the package name by the last element of the import path.
+func importedName(i *ast.ImportSpec) string {
+	if i.Name != nil {
+		return i.Name.Name
+	}
+
+	p, err := strconv.Unquote(i.Path.Value)
+	if err != nil {
+		return ""
+	}
+
+	return path.Base(p)
 }
 
 // importName extracts the qualifier for a given package from a single import spec.
 func importName(i *ast.ImportSpec, pkg *types.Package) (string, bool) {
-	if path, err := strconv.Unquote(i.Path.Value); err == nil && pkg.Path() == path {
+	if importPath, err := strconv.Unquote(i.Path.Value); err == nil && pkg.Path() == importPath {
 		if i.Name == nil { // Standard import: import "fmt"
 			return pkg.Name(), true
 		}
@@ -36,6 +36,7 @@ func TestQualifier_Qualifier(t *testing.T) { //nolint:funlen
 		importsProvider   func(f *ast.File) []*ast.ImportSpec
 		needsImport       bool
 		want              string
+		wantAdded         string
 	}{
 		{
 			name:              "target package is nil",
@@ -62,7 +63,8 @@ func TestQualifier_Qualifier(t *testing.T) { //nolint:funlen
 			},
 			importsProvider: func(f *ast.File) []*ast.ImportSpec { return f.Imports },
 			needsImport:     true,
-			want:            "\"example.com/other\"",
+			want:            "other",
+			wantAdded:       "\"example.com/other\"",
 		},
 		{
 			name:           "target imported without alias",
@@ -103,7 +105,8 @@ func TestQualifier_Qualifier(t *testing.T) { //nolint:funlen
 			},
 			importsProvider: func(f *ast.File) []*ast.ImportSpec { return f.Imports },
 			needsImport:     true,
-			want:            "\"example.com/other\"",
+			want:            "other",
+			wantAdded:       "\"example.com/other\"",
 		},
 		{
 			name:           "target not imported",
@@ -114,7 +117,8 @@ func TestQualifier_Qualifier(t *testing.T) { //nolint:funlen
 			},
 			importsProvider: func(f *ast.File) []*ast.ImportSpec { return f.Imports },
 			needsImport:     true,
-			want:            "\"example.com/other\"",
+			want:            "other",
+			wantAdded:       "\"example.com/other\"",
 		},
 		{
 			name:           "import path unquote error skips spec",
@@ -134,7 +138,20 @@ func TestQualifier_Qualifier(t *testing.T) { //nolint:funlen
 				return f.Imports
 			},
 			needsImport: true,
-			want:        "\"malformed/path\"", // Falls through to strconv.Quote(pkg.Path()) as the malformed import is skipped
+			want:        "skipped", // Adds an import, since the malformed import is skipped
+			wantAdded:   "\"malformed/path\"",
+		},
+		{
+			name:           "target package name conflicts with import",
+			currentPkgPath: "example.com/main",
+			currentFileSrc: `package main; import "example.com/another/other"`,
+			targetPkgProvider: func(_ *types.Package) *types.Package {
+				return types.NewPackage("example.com/other", "other")
+			},
+			importsProvider: func(f *ast.File) []*ast.ImportSpec { return f.Imports },
+			needsImport:     true,
+			want:            "other1",
+			wantAdded:       "other1 \"example.com/other\"",
 		},
 	}
 
@@ -166,6 +183,18 @@ func TestQualifier_Qualifier(t *testing.T) { //nolint:funlen
 			if got, want := q.NeedsImport, tt.needsImport; got != want {
 				t.Errorf("Qualifier(%s) needsImport %t, want %t", targetPkgPath, got, want)
 			}
+
+			var added string
+			if len(q.Added) > 0 {
+				added = q.Added[0].Path.Value
+				if q.Added[0].Name != nil {
+					added = q.Added[0].Name.Name + " " + added
+				}
+			}
+
+			if got, want := added, tt.wantAdded; got != want {
+				t.Errorf("Qualifier(%s) added %q, want %q", targetPkgPath, got, want)
+			}
 		})
 	}
 }
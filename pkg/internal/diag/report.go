@@ -33,13 +33,61 @@ type CategorizedMessage struct {
 }
 
 // Report adds a diagnostic message to the analysis pass results using the [analysis.Pass]'s Report method.
-func (d *Diag) Report(rng analysis.Range, msg CategorizedMessage, fixes []analysis.SuggestedFix) {
+// Alternative fixes are offered in order, the first one is the preferred fix. Without a preferred fix
+// no alternatives are offered, so a driver applying the first fix never picks an alternative. Fixes with
+// a message already offered are skipped, since fix messages must be unique for a diagnostic.
+func (d *Diag) Report(rng analysis.Range, msg CategorizedMessage, alternatives ...[]analysis.SuggestedFix) {
 	d.pass.Report(analysis.Diagnostic{
 		Pos:            rng.Pos(),
 		End:            rng.End(),
 		Category:       msg.Category.String(),
 		Message:        msg.Message,
-		SuggestedFixes: fixes,
+		SuggestedFixes: mergeFixes(alternatives),
 		// URL:            "https://blog.fillmore-labs.com/posts/zerolint" + "#" + msg.Category,
 	})
 }
+
+// mergeFixes concatenates alternative fixes, skipping fixes with duplicate messages.
+// It returns nil when the preferred fix is missing.
+func mergeFixes(alternatives [][]analysis.SuggestedFix) []analysis.SuggestedFix {
+	switch {
+	case len(alternatives) == 0 || len(alternatives[0]) == 0:
+		return nil
+
+	case len(alternatives) == 1:
+		return alternatives[0]
+	}
+
+	var (
+		fixes    []analysis.SuggestedFix
+		messages = make(map[string]struct{})
+	)
+
+	for _, alternative := range alternatives {
+		for _, fix := range alternative {
+			if _, ok := messages[fix.Message]; ok {
+				continue
+			}
+
+			messages[fix.Message] = struct{}{}
+			fixes = append(fixes, fix)
+		}
+	}
+
+	return fixes
+}
+
+// ReportRelated adds a diagnostic message with related information, citing other source locations
+// involved in the issue.
+func (d *Diag) ReportRelated(rng analysis.Range, msg CategorizedMessage,
+	related []analysis.RelatedInformation, fixes []analysis.SuggestedFix,
+) {
+	d.pass.Report(analysis.Diagnostic{
+		Pos:            rng.Pos(),
+		End:            rng.End(),
+		Category:       msg.Category.String(),
+		Message:        msg.Message,
+		SuggestedFixes: fixes,
+		Related:        related,
+	})
+}
@@ -19,6 +19,7 @@ package diag_test
 import (
 	"go/token"
 	"go/types"
+	"slices"
 	"testing"
 
 	"golang.org/x/tools/go/analysis"
@@ -79,3 +80,93 @@ func TestReport(t *testing.T) {
 		t.Error("report was not called")
 	}
 }
+
+func TestReportAlternatives(t *testing.T) {
+	t.Parallel()
+
+	fix1 := []analysis.SuggestedFix{{Message: "Fix1"}}
+	fix2 := []analysis.SuggestedFix{{Message: "Fix2"}, {Message: "Fix1"}}
+
+	tests := [...]struct {
+		name         string
+		alternatives [][]analysis.SuggestedFix
+		want         []string
+	}{
+		{"merged", [][]analysis.SuggestedFix{fix1, fix2}, []string{"Fix1", "Fix2"}},
+		{"no preferred fix", [][]analysis.SuggestedFix{nil, fix2}, nil},
+		{"no fixes", nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+
+			mockPass := &analysis.Pass{
+				Report: func(diag analysis.Diagnostic) {
+					for _, fix := range diag.SuggestedFixes {
+						got = append(got, fix.Message)
+					}
+				},
+				Pkg: types.NewPackage("example.com/test", "test"),
+			}
+
+			c := New(mockPass)
+			c.Report(mockNode{}, CategorizedMessage{Message: "Test message (zl:test)", Category: "test"}, tt.alternatives...)
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("got fixes %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReportRelated(t *testing.T) {
+	t.Parallel()
+
+	var called bool
+
+	mockPass := &analysis.Pass{
+		Report: func(diag analysis.Diagnostic) {
+			t.Helper()
+
+			called = true
+
+			if expectedMessage := "Test message (zl:test)"; diag.Message != expectedMessage {
+				t.Errorf("expected message %q, got %q", expectedMessage, diag.Message)
+			}
+
+			if len(diag.Related) != 2 || diag.Related[1].Message != "Related2" {
+				t.Errorf("unexpected related information: %+v", diag.Related)
+			}
+
+			if len(diag.SuggestedFixes) != 1 || diag.SuggestedFixes[0].Message != "Fix1" {
+				t.Errorf("unexpected suggested fixes: %+v", diag.SuggestedFixes)
+			}
+		},
+		Pkg: types.NewPackage("example.com/test", "test"),
+	}
+
+	c := New(mockPass)
+
+	rng := mockNode{}
+	message := CategorizedMessage{
+		Message:  "Test message (zl:test)",
+		Category: "test",
+	}
+	related := []analysis.RelatedInformation{
+		{Pos: rng.Pos(), End: rng.End(), Message: "Related1"},
+		{Pos: rng.Pos(), End: rng.End(), Message: "Related2"},
+	}
+
+	fixes := []analysis.SuggestedFix{
+		{Message: "Fix1"},
+	}
+
+	c.ReportRelated(rng, message, related, fixes)
+
+	if !called {
+		t.Error("report was not called")
+	}
+}
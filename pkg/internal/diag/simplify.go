@@ -0,0 +1,276 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package diag
+
+import (
+	"cmp"
+	"go/ast"
+	"go/token"
+	"slices"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// SimplifyIf returns edits replacing an if statement without init statement and a constant condition
+// with the branch taken. The parent of the statement determines the replacement: In statement lists,
+// the kept block is unwrapped or the statement is deleted, as else branch of parent the else clause is
+// deleted, otherwise the result is a single statement, like for labeled statements.
+// Blocks declaring names stay blocks, since unwrapping them might cause conflicts with the enclosing scope.
+func (d *Diag) SimplifyIf(n *ast.IfStmt, cond bool, parent ast.Node) []analysis.TextEdit {
+	var keep ast.Stmt = n.Else
+	if cond {
+		keep = n.Body
+	}
+
+	var list, elseOf bool
+
+	switch p := parent.(type) {
+	case *ast.BlockStmt, *ast.CaseClause, *ast.CommClause:
+		list = true
+
+	case *ast.IfStmt:
+		elseOf = p.Else == n
+	}
+
+	switch k := keep.(type) {
+	case nil:
+		switch {
+		case list:
+			return []analysis.TextEdit{d.deleteLines(n)}
+
+		case elseOf: // } else if x == nil { ... }
+			return []analysis.TextEdit{{Pos: parent.(*ast.IfStmt).Body.End(), End: n.End()}}
+
+		default:
+			return []analysis.TextEdit{{Pos: n.Pos(), End: n.End(), NewText: []byte("{}")}}
+		}
+
+	case *ast.BlockStmt:
+		if !list || declares(k) {
+			return []analysis.TextEdit{
+				{Pos: n.Pos(), End: k.Lbrace},
+				{Pos: k.End(), End: n.End()},
+			}
+		}
+
+		return []analysis.TextEdit{
+			{Pos: d.skipLineStart(n.Pos(), false), End: d.skipLineEnd(k.Lbrace + 1)},
+			{Pos: d.skipLineStart(k.Rbrace, true), End: n.End()},
+		}
+
+	case *ast.IfStmt: // else if
+		return []analysis.TextEdit{{Pos: n.Pos(), End: k.Pos()}}
+
+	default: // should not happen
+		return nil
+	}
+}
+
+// RemoveCase returns an edit removing the expression at index i from the case clause,
+// or the whole clause when it is the only expression.
+func (d *Diag) RemoveCase(c *ast.CaseClause, i int) analysis.TextEdit {
+	switch {
+	case len(c.List) == 1:
+		return d.deleteLines(c)
+
+	case i+1 < len(c.List):
+		return analysis.TextEdit{Pos: c.List[i].Pos(), End: c.List[i+1].Pos()}
+
+	default:
+		return analysis.TextEdit{Pos: c.List[i-1].End(), End: c.List[i].End()}
+	}
+}
+
+// declares reports whether the block declares names in its scope.
+func declares(b *ast.BlockStmt) bool {
+	for _, stmt := range b.List {
+		switch s := stmt.(type) {
+		case *ast.DeclStmt:
+			return true
+
+		case *ast.AssignStmt:
+			if s.Tok == token.DEFINE {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// deleteLines returns an edit deleting n. When n is on lines of its own, the lines are deleted,
+// together with a following or, at the end of a block, preceding blank line.
+func (d *Diag) deleteLines(n ast.Node) analysis.TextEdit {
+	pos, ok1 := d.lineStart(n.Pos(), false)
+	end, ok2 := d.lineEnd(n.End())
+
+	if !ok1 || !ok2 {
+		return analysis.TextEdit{Pos: n.Pos(), End: n.End()}
+	}
+
+	if next, ok := d.lineEnd(end); ok {
+		end = next
+	} else if prev, ok := d.lineStart(pos-1, false); ok {
+		pos = prev
+	}
+
+	return analysis.TextEdit{Pos: pos, End: end}
+}
+
+// skipLineStart returns the start of the line containing pos, or the position of the preceding newline
+// with withNewline, when only blanks precede pos on the line. Otherwise, pos is returned.
+func (d *Diag) skipLineStart(pos token.Pos, withNewline bool) token.Pos {
+	if start, ok := d.lineStart(pos, withNewline); ok {
+		return start
+	}
+
+	return pos
+}
+
+// skipLineEnd returns the start of the next line when only blanks follow pos on the line.
+// Otherwise, pos is returned.
+func (d *Diag) skipLineEnd(pos token.Pos) token.Pos {
+	if end, ok := d.lineEnd(pos); ok {
+		return end
+	}
+
+	return pos
+}
+
+// lineStart returns the start of the line containing pos, or the position of the preceding newline
+// with withNewline, when only blanks precede pos on the line.
+func (d *Diag) lineStart(pos token.Pos, withNewline bool) (token.Pos, bool) {
+	file, src, ok := d.source(pos)
+	if !ok {
+		return token.NoPos, false
+	}
+
+	i := file.Offset(pos)
+	for i > 0 && isBlank(src[i-1]) {
+		i--
+	}
+
+	switch {
+	case i > 0 && src[i-1] == '\n':
+		if withNewline {
+			i--
+		}
+
+		return file.Pos(i), true
+
+	case i == 0 && !withNewline:
+		return file.Pos(i), true
+
+	default:
+		return token.NoPos, false
+	}
+}
+
+// lineEnd returns the start of the next line when only blanks follow pos on the line.
+func (d *Diag) lineEnd(pos token.Pos) (token.Pos, bool) {
+	file, src, ok := d.source(pos)
+	if !ok {
+		return token.NoPos, false
+	}
+
+	i := file.Offset(pos)
+	for i < len(src) && isBlank(src[i]) {
+		i++
+	}
+
+	if i < len(src) && src[i] == '\n' {
+		return file.Pos(i + 1), true
+	}
+
+	return token.NoPos, false
+}
+
+// indentation returns the blanks preceding pos, when pos is the first non-blank position on its line.
+func (d *Diag) indentation(pos token.Pos) ([]byte, bool) {
+	start, ok := d.lineStart(pos, false)
+	if !ok {
+		return nil, false
+	}
+
+	file, src, _ := d.source(pos)
+
+	return src[file.Offset(start):file.Offset(pos)], true
+}
+
+// source returns the file containing pos and its content, if available.
+func (d *Diag) source(pos token.Pos) (*token.File, []byte, bool) {
+	if d.pass.ReadFile == nil {
+		return nil, nil, false
+	}
+
+	file := d.pass.Fset.File(pos)
+	if file == nil {
+		return nil, nil, false
+	}
+
+	src, err := d.pass.ReadFile(file.Name())
+	if err != nil || len(src) != file.Size() {
+		return nil, nil, false
+	}
+
+	return file, src, true
+}
+
+func isBlank(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r'
+}
+
+// AddEdits adds companion edits to the first suggested fix. Edits contained in deletions of earlier edits
+// are dropped, since the text they edit is removed. When edits overlap otherwise, no fix is returned.
+func AddEdits(fixes []analysis.SuggestedFix, edits []analysis.TextEdit) []analysis.SuggestedFix {
+	if len(fixes) == 0 || len(edits) == 0 {
+		return fixes
+	}
+
+	edits = slices.Clone(edits)
+	slices.SortStableFunc(edits, func(a, b analysis.TextEdit) int {
+		return cmp.Or(cmp.Compare(a.Pos, b.Pos), cmp.Compare(b.End, a.End))
+	})
+
+	kept := slices.Clone(fixes[0].TextEdits)
+	for _, e := range edits {
+		if slices.ContainsFunc(kept, func(k analysis.TextEdit) bool { return deletes(k, e) }) {
+			continue
+		}
+
+		if slices.ContainsFunc(kept, func(k analysis.TextEdit) bool { return overlaps(k, e) }) {
+			return nil
+		}
+
+		kept = append(kept, e)
+	}
+
+	fixes = slices.Clone(fixes)
+	fixes[0].TextEdits = kept
+
+	return fixes
+}
+
+// overlaps reports whether two edits touch the same text or insert at the same position.
+func overlaps(a, b analysis.TextEdit) bool {
+	return a.Pos < b.End && b.Pos < a.End || a.Pos == b.Pos
+}
+
+// deletes reports whether a deletes the text edited by b.
+func deletes(a, b analysis.TextEdit) bool {
+	return len(a.NewText) == 0 && a.Pos <= b.Pos && b.Pos < a.End && b.End <= a.End
+}
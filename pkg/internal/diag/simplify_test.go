@@ -0,0 +1,269 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package diag_test
+
+import (
+	"go/ast"
+	"go/token"
+	"slices"
+	"testing"
+
+	"golang.org/x/tools/go/analysis"
+
+	. "fillmore-labs.com/zerolint/pkg/internal/diag"
+)
+
+func TestDiag_SimplifyIf(t *testing.T) {
+	t.Parallel()
+
+	const head = "package testpkg\nfunc f(b bool) int {\n"
+
+	tests := [...]struct {
+		name string
+		body string
+		nth  int // The if statement to simplify.
+		cond bool
+		want string
+	}{
+		{
+			name: "delete statement",
+			body: "\tif b {\n\t\treturn 1\n\t}\n\n\treturn 0\n}",
+			cond: false,
+			want: "\treturn 0\n}",
+		},
+		{
+			name: "unwrap body",
+			body: "\tif b {\n\t\treturn 1\n\t} else {\n\t\treturn 2\n\t}\n}",
+			cond: true,
+			want: "\t\treturn 1\n}",
+		},
+		{
+			name: "keep declaring block",
+			body: "\tif b {\n\t\tx := 1\n\t\treturn x\n\t}\n\treturn 0\n}",
+			cond: true,
+			want: "\t{\n\t\tx := 1\n\t\treturn x\n\t}\n\treturn 0\n}",
+		},
+		{
+			name: "keep else if",
+			body: "\tif b {\n\t\treturn 1\n\t} else if !b {\n\t\treturn 2\n\t}\n\treturn 0\n}",
+			cond: false,
+			want: "\tif !b {\n\t\treturn 2\n\t}\n\treturn 0\n}",
+		},
+		{
+			name: "delete else clause",
+			body: "\tif !b {\n\t\treturn 1\n\t} else if b {\n\t\treturn 2\n\t}\n\treturn 0\n}",
+			nth:  1,
+			cond: false,
+			want: "\tif !b {\n\t\treturn 1\n\t}\n\treturn 0\n}",
+		},
+		{
+			name: "labeled statement",
+			body: "L:\n\tif b {\n\t\tgoto L\n\t}\n\treturn 0\n}",
+			cond: false,
+			want: "L:\n\t{}\n\treturn 0\n}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			src := head + tt.body
+			info, pkg, fset, astFile := parseSource(t, "test.go", src)
+
+			d := New(&analysis.Pass{
+				Pkg:       pkg,
+				TypesInfo: info,
+				Fset:      fset,
+				Files:     []*ast.File{astFile},
+				ReadFile:  func(string) ([]byte, error) { return []byte(src), nil },
+			})
+
+			n, parent := findIf(astFile, tt.nth)
+
+			fixes := []analysis.SuggestedFix{{Message: "simplify", TextEdits: d.SimplifyIf(n, tt.cond, parent)}}
+			assertApplied(t, fset, src, fixes, "simplify", head+tt.want)
+		})
+	}
+}
+
+// findIf finds the nth if statement in f and its parent.
+func findIf(f *ast.File, nth int) (n *ast.IfStmt, parent ast.Node) {
+	var stack []ast.Node
+
+	ast.Inspect(f, func(node ast.Node) bool {
+		if node == nil {
+			stack = stack[:len(stack)-1]
+
+			return false
+		}
+
+		if s, ok := node.(*ast.IfStmt); ok {
+			if nth == 0 {
+				n, parent = s, stack[len(stack)-1]
+			}
+
+			nth--
+		}
+
+		stack = append(stack, node)
+
+		return true
+	})
+
+	return n, parent
+}
+
+func TestDiag_RemoveCase(t *testing.T) {
+	t.Parallel()
+
+	const head = "package testpkg\nfunc f(p *int) int {\n\tswitch p {\n"
+
+	tests := [...]struct {
+		name  string
+		cases string
+		i     int
+		want  string
+	}{
+		{
+			name:  "whole clause",
+			cases: "\tcase nil:\n\t\treturn 0\n\n\tdefault:\n\t\treturn 1\n\t}\n}",
+			want:  "\tdefault:\n\t\treturn 1\n\t}\n}",
+		},
+		{
+			name:  "last clause",
+			cases: "\tdefault:\n\t\treturn 1\n\n\tcase nil:\n\t\treturn 0\n\t}\n}",
+			want:  "\tdefault:\n\t\treturn 1\n\t}\n}",
+		},
+		{
+			name:  "first expression",
+			cases: "\tcase nil, p:\n\t\treturn 0\n\t}\n\treturn 1\n}",
+			want:  "\tcase p:\n\t\treturn 0\n\t}\n\treturn 1\n}",
+		},
+		{
+			name:  "last expression",
+			cases: "\tcase p, nil:\n\t\treturn 0\n\t}\n\treturn 1\n}",
+			i:     1,
+			want:  "\tcase p:\n\t\treturn 0\n\t}\n\treturn 1\n}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			src := head + tt.cases
+			info, pkg, fset, astFile := parseSource(t, "test.go", src)
+
+			d := New(&analysis.Pass{
+				Pkg:       pkg,
+				TypesInfo: info,
+				Fset:      fset,
+				Files:     []*ast.File{astFile},
+				ReadFile:  func(string) ([]byte, error) { return []byte(src), nil },
+			})
+
+			var c *ast.CaseClause
+
+			ast.Inspect(astFile, func(n ast.Node) bool {
+				if cc, ok := n.(*ast.CaseClause); ok && c == nil && slices.ContainsFunc(cc.List, isNilIdent) {
+					c = cc
+				}
+
+				return c == nil
+			})
+
+			fixes := []analysis.SuggestedFix{{Message: "remove", TextEdits: []analysis.TextEdit{d.RemoveCase(c, tt.i)}}}
+			assertApplied(t, fset, src, fixes, "remove", head+tt.want)
+		})
+	}
+}
+
+func isNilIdent(x ast.Expr) bool {
+	id, ok := x.(*ast.Ident)
+
+	return ok && id.Name == "nil"
+}
+
+func TestAddEdits(t *testing.T) {
+	t.Parallel()
+
+	fix := analysis.TextEdit{Pos: 10, End: 20}
+
+	tests := [...]struct {
+		name  string
+		edits []analysis.TextEdit
+		want  []token.Pos // nil for no fix
+	}{
+		{
+			name: "add",
+			edits: []analysis.TextEdit{
+				{Pos: 30, End: 35, NewText: []byte("x")},
+				{Pos: 20, End: 20, NewText: []byte("y")},
+			},
+			want: []token.Pos{10, 20, 30},
+		},
+		{
+			name: "drop deleted",
+			edits: []analysis.TextEdit{
+				{Pos: 32, End: 33, NewText: []byte("x")}, // Inside the deletion.
+				{Pos: 30, End: 35},
+			},
+			want: []token.Pos{10, 30},
+		},
+		{
+			name:  "overlap",
+			edits: []analysis.TextEdit{{Pos: 15, End: 25, NewText: []byte("x")}},
+		},
+		{
+			name:  "delete fix",
+			edits: []analysis.TextEdit{{Pos: 5, End: 25}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			fixes := []analysis.SuggestedFix{{Message: "fix", TextEdits: []analysis.TextEdit{fix}}}
+
+			got := AddEdits(fixes, tt.edits)
+
+			if tt.want == nil {
+				if got != nil {
+					t.Errorf("AddEdits() = %v, want no fix", got)
+				}
+
+				return
+			}
+
+			if len(got) != 1 || len(got[0].TextEdits) != len(tt.want) {
+				t.Fatalf("AddEdits() = %v, want edits at %v", got, tt.want)
+			}
+
+			for i, e := range got[0].TextEdits {
+				if e.Pos != tt.want[i] {
+					t.Errorf("AddEdits() edit %d at %d, want %d", i, e.Pos, tt.want[i])
+				}
+			}
+
+			if len(fixes[0].TextEdits) != 1 {
+				t.Errorf("AddEdits() modified the original fix: %v", fixes[0].TextEdits)
+			}
+		})
+	}
+}
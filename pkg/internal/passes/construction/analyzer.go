@@ -0,0 +1,38 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package construction
+
+import (
+	"reflect"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+)
+
+// Analyzer counts value and pointer constructions of zero-sized error types, so that later passes
+// can detect inconsistent usage, also across package boundaries.
+var Analyzer = &analysis.Analyzer{ //nolint:gochecknoglobals
+	Name:             "construction",
+	Doc:              "count value and pointer constructions of zero-sized error types for later passes",
+	URL:              "https://pkg.go.dev/fillmore-labs.com/zerolint/pkg/internal/passes/construction",
+	Run:              run,
+	RunDespiteErrors: true,
+	Requires:         []*analysis.Analyzer{inspect.Analyzer},
+
+	FactTypes:  []analysis.Fact{(*constructionFact)(nil), (*importedConstructionsFact)(nil)},
+	ResultType: reflect.TypeFor[constructionResult](),
+}
@@ -0,0 +1,88 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package construction_test
+
+import (
+	"errors"
+	"testing"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	. "fillmore-labs.com/zerolint/pkg/internal/passes/construction"
+)
+
+func TestConstructionAnalyzer(t *testing.T) {
+	t.Parallel()
+
+	testAnalyzer := &analysis.Analyzer{
+		Name:     "testanalyzer",
+		Doc:      "consumes results from construction.Analyzer for testing",
+		Run:      run,
+		Requires: []*analysis.Analyzer{Analyzer},
+	}
+
+	missingAnalyzer := &analysis.Analyzer{
+		Name: "missinganalyzer",
+		Doc:  "missing results from construction.Analyzer",
+		Run:  run,
+	}
+
+	dir := analysistest.TestData()
+
+	tests := [...]struct {
+		name     string
+		analyzer *analysis.Analyzer
+		pkg      string
+	}{
+		{"construction", testAnalyzer, "test/a"},
+		{"missing", missingAnalyzer, "test/n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			analysistest.Run(t, dir, tt.analyzer, tt.pkg)
+		})
+	}
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	res, err := ResultOf(pass)
+	if err != nil {
+		if errors.Is(err, ErrNoConstructionResult) {
+			return any(nil), nil
+		}
+
+		return nil, err
+	}
+
+	for _, site := range res.Sites {
+		form := "value"
+		if site.Pointer {
+			form = "pointer"
+		}
+
+		counts := res.Counts[site.TypeName]
+		pointer, mixed := counts.Dominant()
+
+		pass.Reportf(site.Expr.Pos(), "%s construction of %q (value=%d, pointer=%d, dominant pointer=%t, mixed=%t)",
+			form, site.TypeName.Name(), counts.Value, counts.Pointer, pointer, mixed)
+	}
+
+	return any(nil), nil
+}
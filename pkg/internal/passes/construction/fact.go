@@ -0,0 +1,101 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package construction
+
+import (
+	"fmt"
+	"go/types"
+)
+
+// constructionFact records how often a zero-sized error type is constructed as value or pointer
+// in its defining package.
+type constructionFact struct {
+	Value, Pointer int
+}
+
+// AFact makes *constructionFact satisfy the [analysis.Fact] interface.
+// [analysis.Fact]s must be pointers to be exported as a fact.
+func (*constructionFact) AFact() {}
+
+func (f *constructionFact) String() string {
+	return fmt.Sprintf("construction(value=%d, pointer=%d)", f.Value, f.Pointer)
+}
+
+// importedConstructionsFact records how often zero-sized error types defined in other packages are constructed
+// as value or pointer in the current package, keyed by [typeKey].
+type importedConstructionsFact struct {
+	Counts map[string]constructionFact
+}
+
+// AFact makes *importedConstructionsFact satisfy the [analysis.Fact] interface.
+func (*importedConstructionsFact) AFact() {}
+
+func (f *importedConstructionsFact) String() string {
+	return fmt.Sprintf("importedConstructions(types=%d)", len(f.Counts))
+}
+
+// typeKey identifies the package-level type tn across packages.
+func typeKey(tn *types.TypeName) string {
+	return tn.Pkg().Path() + "." + tn.Name()
+}
+
+// exportCounts exports a construction fact for the given type defined in the current package.
+func (p pass) exportCounts(tn *types.TypeName, c Counts) {
+	p.ExportObjectFact(tn, &constructionFact{Value: c.Value, Pointer: c.Pointer})
+}
+
+// exportImportedCounts exports the construction counts of types defined in other packages.
+func (p pass) exportImportedCounts(counts map[string]Counts) {
+	if len(counts) == 0 {
+		return
+	}
+
+	f := &importedConstructionsFact{Counts: make(map[string]constructionFact, len(counts))}
+	for key, c := range counts {
+		f.Counts[key] = constructionFact{Value: c.Value, Pointer: c.Pointer}
+	}
+
+	p.ExportPackageFact(f)
+}
+
+// dependencyCounts sums the construction counts of types in dependencies other than the defining packages.
+func (p pass) dependencyCounts() map[string]Counts {
+	counts := make(map[string]Counts)
+
+	for _, pf := range p.AllPackageFacts() {
+		f, ok := pf.Fact.(*importedConstructionsFact)
+		if !ok {
+			continue
+		}
+
+		for key, c := range f.Counts {
+			counts[key] = counts[key].add(Counts{Value: c.Value, Pointer: c.Pointer})
+		}
+	}
+
+	return counts
+}
+
+// importCounts retrieves the construction counts of the given type from its defining package.
+func (p pass) importCounts(tn *types.TypeName) (Counts, bool) {
+	var f constructionFact
+	if !p.ImportObjectFact(tn, &f) {
+		return Counts{}, false
+	}
+
+	return Counts{Value: f.Value, Pointer: f.Pointer}, true
+}
@@ -0,0 +1,77 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package construction
+
+import (
+	"errors"
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// Counts holds the number of value and pointer constructions of a zero-sized error type.
+type Counts struct {
+	Value, Pointer int
+}
+
+// Dominant reports whether pointer construction is the dominant form,
+// and whether the type is constructed in both forms at all.
+// Value construction is preferred in case of a tie.
+func (c Counts) Dominant() (pointer, mixed bool) {
+	return c.Pointer > c.Value, c.Value > 0 && c.Pointer > 0
+}
+
+// add returns the sum of both counts.
+func (c Counts) add(o Counts) Counts {
+	return Counts{Value: c.Value + o.Value, Pointer: c.Pointer + o.Pointer}
+}
+
+// Site is a construction of a zero-sized error type, either a composite literal `T{}`,
+// its address `&T{}` or a call to `new(T)`.
+type Site struct {
+	Expr      ast.Expr        // The construction expression.
+	Type      types.Type      // The constructed type.
+	TypeName  *types.TypeName // The (generic) type definition, used as key for [Result.Counts].
+	Pointer   bool            // The construction results in a pointer.
+	Generated bool            // The construction is in a generated file.
+}
+
+// Result holds the constructions of zero-sized error types in the current package
+// and the combined counts, including those of the defining packages and other dependencies.
+type Result struct {
+	Sites  []Site
+	Counts map[*types.TypeName]Counts
+}
+
+type constructionResult struct {
+	result Result
+}
+
+// ErrNoConstructionResult is returned when the [Analyzer]s result is missing from the [analysis.Pass].
+var ErrNoConstructionResult = errors.New("result of construction.Analyzer missing")
+
+// ResultOf retrieves the constructions of zero-sized error types calculated by the [Analyzer].
+// It returns an error if the construction results are not available.
+func ResultOf(pass *analysis.Pass) (Result, error) {
+	constructionResult, ok := pass.ResultOf[Analyzer].(constructionResult)
+	if !ok {
+		return Result{}, ErrNoConstructionResult
+	}
+
+	return constructionResult.result, nil
+}
@@ -0,0 +1,180 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package construction
+
+import (
+	"errors"
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"fillmore-labs.com/zerolint/pkg/internal/checker"
+)
+
+type pass struct{ *analysis.Pass }
+
+// errorType is the predeclared error interface.
+var errorType = types.Universe.Lookup("error").Type().Underlying().(*types.Interface) //nolint:gochecknoglobals,forcetypeassert
+
+// ErrNoInspectorResult is returned when the ast inspector is missing.
+var ErrNoInspectorResult = errors.New("construction: inspector result missing")
+
+// run collects all constructions of zero-sized error types in the current package.
+// It exports a [constructionFact] for each type defined in the current package and an
+// [importedConstructionsFact] with the counts of types defined elsewhere, so that dependent packages
+// know the dominant form, and combines the facts of all dependencies with local counts.
+func run(ap *analysis.Pass) (any, error) {
+	p := pass{Pass: ap}
+
+	in, ok := p.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	if !ok {
+		return nil, ErrNoInspectorResult
+	}
+
+	var (
+		sites  []Site
+		counts = make(map[*types.TypeName]Counts)
+	)
+
+	for f := range in.Root().Children() {
+		file, ok := f.Node().(*ast.File)
+		if !ok { // should not happen
+			continue
+		}
+
+		generated := ast.IsGenerated(file)
+
+		for c := range f.Preorder((*ast.CompositeLit)(nil), (*ast.CallExpr)(nil)) {
+			site, ok := p.site(c)
+			if !ok {
+				continue
+			}
+
+			site.Generated = generated
+			sites = append(sites, site)
+
+			n := counts[site.TypeName]
+			if site.Pointer {
+				n.Pointer++
+			} else {
+				n.Value++
+			}
+
+			counts[site.TypeName] = n
+		}
+	}
+
+	var (
+		local        = make(map[string]Counts)
+		dependencies = p.dependencyCounts()
+	)
+
+	for tn, n := range counts {
+		if tn.Pkg() == p.Pkg {
+			p.exportCounts(tn, n)
+
+			continue
+		}
+
+		key := typeKey(tn)
+		local[key] = n
+
+		total := n.add(dependencies[key])
+		if imported, ok := p.importCounts(tn); ok {
+			total = total.add(imported)
+		}
+
+		counts[tn] = total
+	}
+
+	p.exportImportedCounts(local)
+
+	return constructionResult{result: Result{Sites: sites, Counts: counts}}, nil
+}
+
+// site checks whether the node at the cursor is a construction of a zero-sized error type.
+func (p pass) site(c inspector.Cursor) (Site, bool) {
+	var (
+		x       ast.Expr
+		t       types.Type
+		pointer bool
+	)
+
+	switch n := c.Node().(type) {
+	case *ast.CompositeLit:
+		x, t = n, p.TypesInfo.TypeOf(n)
+
+		// Find the enclosing &T{}, skipping parentheses.
+		for c = c.Parent(); ; c = c.Parent() {
+			if _, ok := c.Node().(*ast.ParenExpr); !ok {
+				break
+			}
+		}
+
+		if u, ok := c.Node().(*ast.UnaryExpr); ok && u.Op == token.AND {
+			x, pointer = u, true
+		}
+
+	case *ast.CallExpr:
+		if len(n.Args) != 1 {
+			return Site{}, false
+		}
+
+		if fun, ok := ast.Unparen(n.Fun).(*ast.Ident); !ok || fun.Name != "new" {
+			return Site{}, false
+		}
+
+		if tv, ok := p.TypesInfo.Types[n.Fun]; !ok || !tv.IsBuiltin() {
+			return Site{}, false
+		}
+
+		x, t, pointer = n, p.TypesInfo.TypeOf(n.Args[0]), true
+
+	default:
+		return Site{}, false
+	}
+
+	tn, ok := zeroSizedErrorType(t)
+	if !ok {
+		return Site{}, false
+	}
+
+	return Site{Expr: x, Type: t, TypeName: tn, Pointer: pointer}, true
+}
+
+// zeroSizedErrorType checks whether t is a named zero-sized type where the type or a pointer to it
+// implements the error interface and returns its definition.
+func zeroSizedErrorType(t types.Type) (*types.TypeName, bool) {
+	named, ok := types.Unalias(t).(*types.Named)
+	if !ok {
+		return nil, false
+	}
+
+	if !checker.ZeroSized(named) {
+		return nil, false
+	}
+
+	if !types.Implements(named, errorType) && !types.Implements(types.NewPointer(named), errorType) {
+		return nil, false
+	}
+
+	return named.Origin().Obj(), true
+}
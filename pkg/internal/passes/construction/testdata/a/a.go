@@ -0,0 +1,46 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package a
+
+import (
+	"test/a/b"
+	"test/a/c"
+)
+
+type localError struct{}
+
+func (localError) Error() string { return "local" }
+
+type notAnError struct{}
+
+type sizedError struct{ msg string }
+
+func (e sizedError) Error() string { return e.msg }
+
+var _ = c.Errors
+
+func Constructions() []any {
+	return []any{
+		b.PointerError{},  // want `^value construction of "PointerError" \(value=1, pointer=2, dominant pointer=true, mixed=true\)$`
+		&b.ValueError{},   // want `^pointer construction of "ValueError" \(value=1, pointer=3, dominant pointer=true, mixed=true\)$`
+		localError{},      // want `^value construction of "localError" \(value=1, pointer=1, dominant pointer=false, mixed=true\)$`
+		(&(localError{})), // want `^pointer construction of "localError" \(value=1, pointer=1, dominant pointer=false, mixed=true\)$`
+		notAnError{},
+		&sizedError{},
+		new(int),
+	}
+}
@@ -0,0 +1,29 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package b
+
+type PointerError struct{}
+
+func (*PointerError) Error() string { return "pointer" }
+
+type ValueError struct{}
+
+func (ValueError) Error() string { return "value" }
+
+func Errors() []error {
+	return []error{&PointerError{}, new(PointerError), ValueError{}}
+}
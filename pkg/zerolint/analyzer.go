@@ -22,11 +22,14 @@ import (
 	"regexp"
 
 	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/buildssa"
 	"golang.org/x/tools/go/analysis/passes/inspect"
 
 	"fillmore-labs.com/zerolint/pkg/internal/excludes"
+	"fillmore-labs.com/zerolint/pkg/internal/passes/construction"
 	"fillmore-labs.com/zerolint/pkg/internal/passes/exclusions"
 	"fillmore-labs.com/zerolint/pkg/internal/set"
+	"fillmore-labs.com/zerolint/pkg/zerolint/level"
 	"fillmore-labs.com/zerolint/pkg/zerolint/result"
 )
 
@@ -53,18 +56,13 @@ spec-compliant Go code.`
 func New(opts ...Option) *analysis.Analyzer {
 	o := makeOptions(opts)
 
-	requires := []*analysis.Analyzer{inspect.Analyzer, exclusions.Analyzer}
-	if !o.excludeComments {
-		requires = []*analysis.Analyzer{inspect.Analyzer}
-	}
-
 	a := &analysis.Analyzer{
 		Name: Name,
 		Doc:  Doc,
 		URL:  URL,
 		Run:  o.run,
 
-		Requires:   requires,
+		Requires:   o.requires(),
 		ResultType: reflect.TypeFor[result.Detected](),
 	}
 
@@ -74,16 +72,55 @@ func New(opts ...Option) *analysis.Analyzer {
 		}
 
 		// Use programmatic options as defaults for flags.
-		a.Flags.TextVar(&o.level, "level", o.level, "analysis `level` (basic, extended, full)")
+		a.Flags.Var(levelFlag{o: o, a: a}, "level", "analysis `level` (basic, extended, full)")
 		a.Flags.TextVar(o.regex, "match", o.regex, "only check types matching this `regex`, useful with -fix")
 		a.Flags.Func("excluded", "read excluded types from this `file`", o.readExcludedFile)
 		a.Flags.BoolVar(&o.zeroTrace, "zerotrace", o.zeroTrace, "trace found zero-sized types")
 		a.Flags.BoolVar(&o.generated, "generated", o.generated, "check generated files")
+		a.Flags.BoolVar(&o.alternatives, "alternatives", o.alternatives, "offer alternative fixes, for editors")
 	}
 
 	return a
 }
 
+// requires returns the analyzers needed at the configured level.
+func (o *options) requires() []*analysis.Analyzer {
+	requires := []*analysis.Analyzer{inspect.Analyzer}
+	if o.excludeComments {
+		requires = append(requires, exclusions.Analyzer)
+	}
+
+	if o.level.AtLeast(level.Extended) {
+		requires = append(requires, buildssa.Analyzer, construction.Analyzer)
+	}
+
+	return requires
+}
+
+// levelFlag sets the analysis level from the command line, updating the required analyzers.
+type levelFlag struct {
+	o *options
+	a *analysis.Analyzer
+}
+
+func (f levelFlag) String() string {
+	if f.o == nil { // zero value, see [flag.PrintDefaults]
+		return ""
+	}
+
+	return f.o.level.String()
+}
+
+func (f levelFlag) Set(s string) error {
+	if err := f.o.level.UnmarshalText([]byte(s)); err != nil {
+		return err
+	}
+
+	f.a.Requires = f.o.requires()
+
+	return nil
+}
+
 func (o *options) readExcludedFile(name string) error {
 	if name == "" {
 		return nil
@@ -21,12 +21,14 @@ import (
 	"log"
 	"os"
 	"regexp"
+	"slices"
 	"strings"
 	"testing"
 
 	"golang.org/x/tools/go/analysis/analysistest"
 
 	"fillmore-labs.com/zerolint/pkg/internal/excludes"
+	"fillmore-labs.com/zerolint/pkg/internal/passes/construction"
 	. "fillmore-labs.com/zerolint/pkg/zerolint"
 	"fillmore-labs.com/zerolint/pkg/zerolint/level"
 )
@@ -113,3 +115,36 @@ func TestAnalyzer(t *testing.T) { //nolint:funlen
 		})
 	}
 }
+
+func TestRequires(t *testing.T) {
+	t.Parallel()
+
+	tests := [...]struct {
+		name  string
+		opts  Options
+		level string
+		want  bool
+	}{
+		{"basic", Options{WithLevel(level.Basic)}, "", false},
+		{"extended", Options{WithLevel(level.Extended)}, "", true},
+		{"extended via flag", Options{WithFlags(true)}, "extended", true},
+		{"basic via flag", Options{WithLevel(level.Full), WithFlags(true)}, "basic", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			a := New(tt.opts)
+			if tt.level != "" {
+				if err := a.Flags.Set("level", tt.level); err != nil {
+					t.Fatalf("Can't set level %s: %v", tt.level, err)
+				}
+			}
+
+			if got := slices.Contains(a.Requires, construction.Analyzer); got != tt.want {
+				t.Errorf("Got construction.Analyzer required %t, expected %t", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,277 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fixverify
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// verifier re-type-checks packages with fixes applied.
+type verifier struct {
+	pkgs    []*packages.Package // All packages in dependency order.
+	files   map[string][]*packages.Package
+	sources map[string][]byte
+}
+
+func newVerifier(roots []*packages.Package) *verifier {
+	v := &verifier{
+		files:   make(map[string][]*packages.Package),
+		sources: make(map[string][]byte),
+	}
+
+	packages.Visit(roots, nil, func(pkg *packages.Package) {
+		v.pkgs = append(v.pkgs, pkg)
+		for _, file := range pkg.CompiledGoFiles {
+			v.files[file] = append(v.files[file], pkg)
+		}
+	})
+
+	return v
+}
+
+// verifiable reports whether all files touched by f are type-checked, which excludes cgo files.
+func (v *verifier) verifiable(f *fix) bool {
+	for _, e := range f.edits {
+		if _, ok := v.files[e.file]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// source returns the original content of a file.
+func (v *verifier) source(file string) ([]byte, error) {
+	if src, ok := v.sources[file]; ok {
+		return src, nil
+	}
+
+	src, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	v.sources[file] = src
+
+	return src, nil
+}
+
+// apply returns the content of all files modified by fixes.
+func (v *verifier) apply(fixes []*fix) (map[string][]byte, error) {
+	edits := make(map[string][]edit)
+	for _, f := range fixes {
+		for _, e := range f.edits {
+			edits[e.file] = append(edits[e.file], e)
+		}
+	}
+
+	files := make(map[string][]byte, len(edits))
+	for file, es := range edits {
+		src, err := v.source(file)
+		if err != nil {
+			return nil, err
+		}
+
+		slices.SortStableFunc(es, compareEdits)
+		files[file] = applyEdits(src, es)
+	}
+
+	return files, nil
+}
+
+// verify returns the fixes that type-check, setting the reason on the dropped ones.
+func (v *verifier) verify(fixes []*fix) []*fix {
+	if len(fixes) == 0 || v.check(fixes) == nil {
+		return fixes
+	}
+
+	// Try the fixes of each directory together.
+	var (
+		accepted []*fix
+		rejected []*fix
+	)
+
+	for _, group := range groupByDir(fixes) {
+		if v.check(append(slices.Clip(accepted), group...)) == nil {
+			accepted = append(accepted, group...)
+		} else {
+			rejected = append(rejected, group...)
+		}
+	}
+
+	// Try single fixes until no more fixes are accepted.
+	for progress := true; progress && len(rejected) > 0; {
+		progress = false
+
+		remaining := rejected[:0]
+		for _, f := range rejected {
+			if err := v.check(append(slices.Clip(accepted), f)); err != nil {
+				f.err = err
+				remaining = append(remaining, f)
+
+				continue
+			}
+
+			f.err = nil
+			accepted = append(accepted, f)
+			progress = true
+		}
+
+		rejected = remaining
+	}
+
+	return accepted
+}
+
+// groupByDir groups fixes by the directory of their first edit.
+func groupByDir(fixes []*fix) [][]*fix {
+	var (
+		groups [][]*fix
+		index  = make(map[string]int)
+	)
+
+	for _, f := range fixes {
+		dir := filepath.Dir(f.edits[0].file)
+
+		i, ok := index[dir]
+		if !ok {
+			i = len(groups)
+			index[dir] = i
+			groups = append(groups, nil)
+		}
+
+		groups[i] = append(groups[i], f)
+	}
+
+	return groups
+}
+
+// check type-checks the packages containing files modified by fixes and all packages importing them.
+func (v *verifier) check(fixes []*fix) error {
+	files, err := v.apply(fixes)
+	if err != nil {
+		return err
+	}
+
+	affected := make(map[*packages.Package]bool)
+	for file := range files {
+		for _, pkg := range v.files[file] {
+			affected[pkg] = true
+		}
+	}
+
+	fset := token.NewFileSet()
+	checked := make(map[*packages.Package]*types.Package)
+
+	for _, pkg := range v.pkgs { // Dependencies come first.
+		if !affected[pkg] && !importsAny(pkg, affected) {
+			continue
+		}
+
+		affected[pkg] = true
+
+		tpkg, err := v.checkPackage(fset, pkg, files, checked)
+		if err != nil {
+			return err
+		}
+
+		checked[pkg] = tpkg
+	}
+
+	return nil
+}
+
+// checkPackage type-checks a single package, using re-checked dependencies where available.
+func (v *verifier) checkPackage(fset *token.FileSet, pkg *packages.Package,
+	files map[string][]byte, checked map[*packages.Package]*types.Package,
+) (*types.Package, error) {
+	syntax := make([]*ast.File, 0, len(pkg.CompiledGoFiles))
+	for _, file := range pkg.CompiledGoFiles {
+		src, ok := files[file]
+		if !ok {
+			var err error
+			if src, err = v.source(file); err != nil {
+				return nil, err
+			}
+		}
+
+		f, err := parser.ParseFile(fset, file, src, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrTypeCheck, err)
+		}
+
+		syntax = append(syntax, f)
+	}
+
+	var firstErr error
+
+	conf := &types.Config{
+		Importer: importerFunc(func(path string) (*types.Package, error) {
+			imp, ok := pkg.Imports[path]
+			if !ok {
+				return nil, fmt.Errorf("no package for import %q", path)
+			}
+
+			if tpkg, ok := checked[imp]; ok {
+				return tpkg, nil
+			}
+
+			return imp.Types, nil
+		}),
+		Sizes: pkg.TypesSizes,
+		Error: func(err error) {
+			if firstErr == nil {
+				firstErr = err
+			}
+		},
+	}
+
+	if pkg.Module != nil && pkg.Module.GoVersion != "" {
+		conf.GoVersion = "go" + pkg.Module.GoVersion
+	}
+
+	tpkg, _ := conf.Check(pkg.PkgPath, fset, syntax, nil)
+	if firstErr != nil {
+		return nil, fmt.Errorf("%w: %w", ErrTypeCheck, firstErr)
+	}
+
+	return tpkg, nil
+}
+
+type importerFunc func(path string) (*types.Package, error)
+
+func (f importerFunc) Import(path string) (*types.Package, error) { return f(path) }
+
+// importsAny reports whether pkg directly imports one of the given packages.
+func importsAny(pkg *packages.Package, pkgs map[*packages.Package]bool) bool {
+	for _, imp := range pkg.Imports {
+		if pkgs[imp] {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,125 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fixverify
+
+import (
+	"cmp"
+	"fmt"
+	"go/token"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// fix is the first suggested fix of a diagnostic, with edits resolved to file offsets.
+type fix struct {
+	key   string
+	posn  token.Position
+	edits []edit
+
+	// err is the reason the fix was dropped.
+	err error
+}
+
+// edit replaces the bytes [start, end) of a file with text.
+type edit struct {
+	file       string
+	start, end int
+	text       string
+}
+
+// newFix resolves the edits of a suggested fix, returning false if an edit can't be mapped to a file.
+func newFix(fset *token.FileSet, posn token.Position, sf analysis.SuggestedFix) (*fix, bool) {
+	if len(sf.TextEdits) == 0 {
+		return nil, false
+	}
+
+	edits := make([]edit, 0, len(sf.TextEdits))
+	for _, te := range sf.TextEdits {
+		file := fset.File(te.Pos)
+		if file == nil {
+			return nil, false
+		}
+
+		end := te.End
+		if !end.IsValid() {
+			end = te.Pos
+		}
+
+		if int(end) > file.Base()+file.Size() || end < te.Pos {
+			return nil, false
+		}
+
+		edits = append(edits, edit{
+			file:  file.Name(),
+			start: file.Offset(te.Pos),
+			end:   file.Offset(end),
+			text:  string(te.NewText),
+		})
+	}
+
+	slices.SortStableFunc(edits, compareEdits)
+
+	var key strings.Builder
+	for _, e := range edits {
+		fmt.Fprintf(&key, "%s:%d:%d:%q;", e.file, e.start, e.end, e.text)
+	}
+
+	return &fix{key: key.String(), posn: posn, edits: edits}, true
+}
+
+func compareEdits(a, b edit) int {
+	return cmp.Or(
+		cmp.Compare(a.file, b.file),
+		cmp.Compare(a.start, b.start),
+		cmp.Compare(a.end, b.end),
+	)
+}
+
+// overlaps reports whether the edits of f and g touch the same bytes or insert at the same offset.
+func (f *fix) overlaps(g *fix) bool {
+	for _, a := range f.edits {
+		for _, b := range g.edits {
+			if a.file != b.file {
+				continue
+			}
+
+			if a.start < b.end && b.start < a.end || a.start == b.start {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// applyEdits applies non-overlapping edits sorted by offset to src.
+func applyEdits(src []byte, edits []edit) []byte {
+	var (
+		out  []byte
+		last int
+	)
+
+	for _, e := range edits {
+		out = append(out, src[last:e.start]...)
+		out = append(out, e.text...)
+		last = e.end
+	}
+
+	return append(out, src[last:]...)
+}
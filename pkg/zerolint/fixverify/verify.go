@@ -0,0 +1,233 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package fixverify applies suggested fixes in memory and keeps only those that still type-check.
+package fixverify
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"go/token"
+	"slices"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/checker"
+	"golang.org/x/tools/go/packages"
+)
+
+// Finding is a diagnostic that has not been fixed.
+type Finding struct {
+	Posn    token.Position
+	Message string
+
+	// Unfixable is the reason the suggested fix was dropped, or nil when the diagnostic had no fix.
+	Unfixable error
+}
+
+// Result is the outcome of verifying the suggested fixes.
+type Result struct {
+	// Files maps file names to their fixed content, for all files modified by verified fixes.
+	Files map[string][]byte
+
+	// Findings are the diagnostics that have not been fixed, sorted by position.
+	Findings []Finding
+
+	// Fixed is the number of diagnostics fixed.
+	Fixed int
+}
+
+var (
+	// ErrPackageErrors is returned when packages could not be loaded without errors.
+	ErrPackageErrors = errors.New("errors loading packages")
+
+	// ErrConflict is the reason for dropping fixes that overlap with other fixes.
+	ErrConflict = errors.New("fix conflicts with another fix")
+
+	// ErrTypeCheck is the reason for dropping fixes that introduce type errors.
+	ErrTypeCheck = errors.New("fix introduces type error")
+
+	// ErrUnverifiable is the reason for dropping fixes of files that can't be type-checked, like cgo files.
+	ErrUnverifiable = errors.New("fix can't be verified")
+)
+
+// Verify loads the packages matching patterns in dir, runs the analyzer on them and applies the first suggested fix
+// of each diagnostic in memory. Fixes that introduce type errors in the fixed package or packages importing it
+// are dropped and their diagnostics are reported as unfixable.
+//
+// All fixes are tried together first, then the fixes of each directory and finally each fix on its own,
+// so fixes that only type-check together with fixes in other directories might be dropped.
+func Verify(a *analysis.Analyzer, dir string, patterns ...string) (Result, error) {
+	cfg := &packages.Config{
+		Mode:  packages.LoadAllSyntax,
+		Dir:   dir,
+		Tests: true,
+	}
+
+	pkgs, err := packages.Load(cfg, patterns...)
+	if err != nil {
+		return Result{}, fmt.Errorf("loading packages: %w", err)
+	}
+
+	if n := packages.PrintErrors(pkgs); n > 0 {
+		return Result{}, ErrPackageErrors
+	}
+
+	graph, err := checker.Analyze([]*analysis.Analyzer{a}, pkgs, nil)
+	if err != nil {
+		return Result{}, fmt.Errorf("analyzing packages: %w", err)
+	}
+
+	c := newCollector()
+	for act := range graph.All() {
+		if !act.IsRoot || act.Analyzer != a {
+			continue
+		}
+
+		if act.Err != nil {
+			return Result{}, fmt.Errorf("analyzing %s: %w", act.Package.PkgPath, act.Err)
+		}
+
+		c.add(act.Package, act.Diagnostics)
+	}
+
+	v := newVerifier(pkgs)
+	accepted := v.verify(c.candidates(v))
+
+	return c.result(v, accepted)
+}
+
+// collector gathers diagnostics and their first suggested fix, deduplicated over test variants.
+type collector struct {
+	diagnostics map[diagKey]*diagnostic
+	fixes       map[string]*fix
+}
+
+type diagKey struct {
+	posn    token.Position
+	message string
+}
+
+type diagnostic struct {
+	diagKey
+	fix *fix
+}
+
+func newCollector() *collector {
+	return &collector{
+		diagnostics: make(map[diagKey]*diagnostic),
+		fixes:       make(map[string]*fix),
+	}
+}
+
+// add records the diagnostics reported for a package.
+func (c *collector) add(pkg *packages.Package, diagnostics []analysis.Diagnostic) {
+	for _, d := range diagnostics {
+		key := diagKey{posn: pkg.Fset.Position(d.Pos), message: d.Message}
+		if _, ok := c.diagnostics[key]; ok {
+			continue
+		}
+
+		diag := &diagnostic{diagKey: key}
+		c.diagnostics[key] = diag
+
+		if len(d.SuggestedFixes) == 0 {
+			continue
+		}
+
+		f, ok := newFix(pkg.Fset, key.posn, d.SuggestedFixes[0])
+		if !ok {
+			continue
+		}
+
+		if prev, ok := c.fixes[f.key]; ok {
+			f = prev // The same fix for a different diagnostic.
+		} else {
+			c.fixes[f.key] = f
+		}
+
+		diag.fix = f
+	}
+}
+
+// candidates returns the fixes sorted by position, dropping fixes that overlap with earlier ones
+// or can't be verified.
+func (c *collector) candidates(v *verifier) []*fix {
+	fixes := make([]*fix, 0, len(c.fixes))
+	for _, f := range c.fixes {
+		fixes = append(fixes, f)
+	}
+
+	slices.SortFunc(fixes, func(a, b *fix) int {
+		return cmp.Or(
+			cmp.Compare(a.posn.Filename, b.posn.Filename),
+			cmp.Compare(a.posn.Offset, b.posn.Offset),
+			cmp.Compare(a.key, b.key),
+		)
+	})
+
+	var accepted []*fix
+
+	for _, f := range fixes {
+		switch {
+		case !v.verifiable(f):
+			f.err = ErrUnverifiable
+
+		case slices.ContainsFunc(accepted, f.overlaps):
+			f.err = ErrConflict
+
+		default:
+			accepted = append(accepted, f)
+		}
+	}
+
+	return accepted
+}
+
+// result applies the accepted fixes and collects the diagnostics not fixed.
+func (c *collector) result(v *verifier, accepted []*fix) (Result, error) {
+	files, err := v.apply(accepted)
+	if err != nil {
+		return Result{}, err
+	}
+
+	res := Result{Files: files}
+
+	for _, d := range c.diagnostics {
+		if d.fix != nil && d.fix.err == nil {
+			res.Fixed++
+
+			continue
+		}
+
+		f := Finding{Posn: d.posn, Message: d.message}
+		if d.fix != nil {
+			f.Unfixable = d.fix.err
+		}
+
+		res.Findings = append(res.Findings, f)
+	}
+
+	slices.SortFunc(res.Findings, func(a, b Finding) int {
+		return cmp.Or(
+			cmp.Compare(a.Posn.Filename, b.Posn.Filename),
+			cmp.Compare(a.Posn.Offset, b.Posn.Offset),
+			cmp.Compare(a.Message, b.Message),
+		)
+	})
+
+	return res, nil
+}
@@ -0,0 +1,80 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fixverify_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	"fillmore-labs.com/zerolint/pkg/zerolint"
+	. "fillmore-labs.com/zerolint/pkg/zerolint/fixverify"
+	"fillmore-labs.com/zerolint/pkg/zerolint/level"
+)
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	a := zerolint.New(zerolint.WithLevel(level.Extended))
+
+	res, err := Verify(a, analysistest.TestData(), "./...")
+	if err != nil {
+		t.Fatalf("Verify() error = %v", err)
+	}
+
+	if res.Fixed != 1 {
+		t.Errorf("Verify() fixed = %d, want 1", res.Fixed)
+	}
+
+	if len(res.Files) != 1 {
+		t.Fatalf("Verify() files = %d, want 1", len(res.Files))
+	}
+
+	for file, content := range res.Files {
+		if !strings.HasSuffix(file, "p.go") {
+			t.Errorf("Verify() modified %s, want p.go", file)
+		}
+
+		if want := "func (Token) String() string"; !strings.Contains(string(content), want) {
+			t.Errorf("Verify() fixed content does not contain %q", want)
+		}
+
+		if want := "var Current *Token"; !strings.Contains(string(content), want) {
+			t.Errorf("Verify() fixed content does not contain %q", want)
+		}
+	}
+
+	if len(res.Findings) != 1 {
+		t.Fatalf("Verify() findings = %v, want 1", res.Findings)
+	}
+
+	f := res.Findings[0]
+
+	if want := `variable "Current" is pointer to zero-sized type "test/p.Token" (zl:var)`; f.Message != want {
+		t.Errorf("Verify() finding = %q, want %q", f.Message, want)
+	}
+
+	if !errors.Is(f.Unfixable, ErrTypeCheck) {
+		t.Errorf("Verify() unfixable = %v, want %v", f.Unfixable, ErrTypeCheck)
+	}
+
+	if want := "q.go"; f.Unfixable == nil || !strings.Contains(f.Unfixable.Error(), want) {
+		t.Errorf("Verify() unfixable = %v, want error in %s", f.Unfixable, want)
+	}
+}
@@ -36,6 +36,7 @@ type options struct {
 	zeroTrace       bool
 	withFlags       bool
 	excludeComments bool
+	alternatives    bool
 }
 
 // defaultOptions returns a [options] struct initialized with default values.
@@ -179,6 +180,29 @@ func (o generatedOption) apply(opts *options) {
 	opts.generated = o.generated
 }
 
+// WithAlternatives is an [Option] to configure offering alternative suggested fixes, disabled by default.
+// Editors may offer all of them, while drivers applying fixes with -fix use the first one.
+func WithAlternatives(alternatives bool) Option {
+	return alternativesOption{alternatives: alternatives}
+}
+
+type alternativesOption struct {
+	alternatives bool
+}
+
+// LogValue implements the [slog.LogValuer] interface.
+func (o alternativesOption) LogValue() slog.Value {
+	return slog.BoolValue(o.alternatives)
+}
+
+func (o alternativesOption) key() string {
+	return "alternatives"
+}
+
+func (o alternativesOption) apply(opts *options) {
+	opts.alternatives = o.alternatives
+}
+
 // WithRegex is an [Option] to configure detecting only matching types.
 func WithRegex(re *regexp.Regexp) Option {
 	return reOption{re: re}
@@ -32,6 +32,7 @@ func TestOptions(t *testing.T) {
 	t.Parallel()
 
 	opts := Options{
+		WithAlternatives(true),
 		WithExcludeComments(true),
 		WithExcludes([]string{"exclude1", "exclude2"}),
 		WithFlags(false),
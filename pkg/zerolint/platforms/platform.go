@@ -0,0 +1,58 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package platforms
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Platform is a GOOS/GOARCH build configuration.
+type Platform struct {
+	GOOS, GOARCH string
+}
+
+// String returns the platform in GOOS/GOARCH notation.
+func (p Platform) String() string {
+	return p.GOOS + "/" + p.GOARCH
+}
+
+// ErrInvalidPlatform is returned when a platform is not in GOOS/GOARCH notation.
+var ErrInvalidPlatform = errors.New("invalid platform, expected GOOS/GOARCH")
+
+// Parse parses a comma-separated list of platforms, like "linux/amd64,js/wasm".
+// Duplicates are removed, otherwise the order is preserved.
+func Parse(list string) ([]Platform, error) {
+	var platforms []Platform
+
+	for s := range strings.SplitSeq(list, ",") {
+		s = strings.TrimSpace(s)
+
+		goos, goarch, ok := strings.Cut(s, "/")
+		if !ok || goos == "" || goarch == "" || strings.Contains(goarch, "/") {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidPlatform, s)
+		}
+
+		if p := (Platform{GOOS: goos, GOARCH: goarch}); !slices.Contains(platforms, p) {
+			platforms = append(platforms, p)
+		}
+	}
+
+	return platforms, nil
+}
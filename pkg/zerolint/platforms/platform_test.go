@@ -0,0 +1,68 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package platforms_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	. "fillmore-labs.com/zerolint/pkg/zerolint/platforms"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tests := [...]struct {
+		name    string
+		list    string
+		want    []Platform
+		wantErr error
+	}{
+		{"single", "linux/amd64", []Platform{{"linux", "amd64"}}, nil},
+		{"multiple", "linux/amd64, windows/arm64,js/wasm", []Platform{{"linux", "amd64"}, {"windows", "arm64"}, {"js", "wasm"}}, nil},
+		{"duplicate", "linux/amd64,linux/amd64", []Platform{{"linux", "amd64"}}, nil},
+		{"empty", "", nil, ErrInvalidPlatform},
+		{"missing arch", "linux", nil, ErrInvalidPlatform},
+		{"empty arch", "linux/", nil, ErrInvalidPlatform},
+		{"too many parts", "linux/amd64/v3", nil, ErrInvalidPlatform},
+		{"trailing comma", "linux/amd64,", nil, ErrInvalidPlatform},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Parse(tt.list)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Parse(%q) error = %v, want %v", tt.list, err, tt.wantErr)
+			}
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Parse(%q) = %v, want %v", tt.list, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlatform_String(t *testing.T) {
+	t.Parallel()
+
+	if got, want := (Platform{GOOS: "js", GOARCH: "wasm"}).String(), "js/wasm"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package platforms
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"go/token"
+	"os"
+	"slices"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/checker"
+	"golang.org/x/tools/go/packages"
+
+	"fillmore-labs.com/zerolint/pkg/zerolint/result"
+)
+
+// Finding is a diagnostic together with the platforms it was reported for.
+type Finding struct {
+	Posn      token.Position
+	Message   string
+	Platforms []Platform
+}
+
+// Result is the merged outcome of analyzing packages for multiple platforms.
+type Result struct {
+	// Findings are deduplicated diagnostics, sorted by position.
+	Findings []Finding
+
+	// Detected holds the zero-sized types found per package path, merged over all platforms.
+	Detected map[string]result.Detected
+}
+
+// ErrPackageErrors is returned when packages could not be loaded without errors.
+var ErrPackageErrors = errors.New("errors loading packages")
+
+// Analyze loads the packages matching patterns in dir for each platform,
+// runs the analyzer on them and merges the results.
+func Analyze(a *analysis.Analyzer, platforms []Platform, dir string, patterns ...string) (Result, error) {
+	type findingKey struct {
+		posn    token.Position
+		message string
+	}
+
+	findings := make(map[findingKey]*Finding)
+	detected := make(map[string][]result.Detected)
+
+	for _, p := range platforms {
+		graph, err := analyze(a, p, dir, patterns)
+		if err != nil {
+			return Result{}, err
+		}
+
+		for act := range graph.All() {
+			if !act.IsRoot || act.Analyzer != a {
+				continue
+			}
+
+			if act.Err != nil {
+				return Result{}, fmt.Errorf("analyzing %s for %s: %w", act.Package.PkgPath, p, act.Err)
+			}
+
+			for _, d := range act.Diagnostics {
+				key := findingKey{posn: act.Package.Fset.Position(d.Pos), message: d.Message}
+
+				f, ok := findings[key]
+				if !ok {
+					f = &Finding{Posn: key.posn, Message: key.message}
+					findings[key] = f
+				}
+
+				if !slices.Contains(f.Platforms, p) { // Test variants report the same diagnostics.
+					f.Platforms = append(f.Platforms, p)
+				}
+			}
+
+			if d, ok := act.Result.(result.Detected); ok && !d.Empty() {
+				detected[act.Package.PkgPath] = append(detected[act.Package.PkgPath], d)
+			}
+		}
+	}
+
+	res := Result{
+		Findings: make([]Finding, 0, len(findings)),
+		Detected: make(map[string]result.Detected, len(detected)),
+	}
+
+	for _, f := range findings {
+		res.Findings = append(res.Findings, *f)
+	}
+
+	slices.SortFunc(res.Findings, func(a, b Finding) int {
+		return cmp.Or(
+			cmp.Compare(a.Posn.Filename, b.Posn.Filename),
+			cmp.Compare(a.Posn.Offset, b.Posn.Offset),
+			cmp.Compare(a.Message, b.Message),
+		)
+	})
+
+	for path, ds := range detected {
+		res.Detected[path] = result.Merge(ds...)
+	}
+
+	return res, nil
+}
+
+// analyze loads the packages for a single platform and runs the analyzer.
+func analyze(a *analysis.Analyzer, p Platform, dir string, patterns []string) (*checker.Graph, error) {
+	cfg := &packages.Config{
+		Mode:  packages.LoadAllSyntax,
+		Dir:   dir,
+		Env:   append(os.Environ(), "GOOS="+p.GOOS, "GOARCH="+p.GOARCH),
+		Tests: true,
+	}
+
+	pkgs, err := packages.Load(cfg, patterns...)
+	if err != nil {
+		return nil, fmt.Errorf("loading packages for %s: %w", p, err)
+	}
+
+	if n := packages.PrintErrors(pkgs); n > 0 {
+		return nil, fmt.Errorf("%w for %s", ErrPackageErrors, p)
+	}
+
+	graph, err := checker.Analyze([]*analysis.Analyzer{a}, pkgs, nil)
+	if err != nil {
+		return nil, fmt.Errorf("analyzing packages for %s: %w", p, err)
+	}
+
+	return graph, nil
+}
@@ -0,0 +1,71 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package platforms_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+
+	"fillmore-labs.com/zerolint/pkg/zerolint"
+	. "fillmore-labs.com/zerolint/pkg/zerolint/platforms"
+)
+
+func TestAnalyze(t *testing.T) {
+	t.Parallel()
+
+	linux, windows := Platform{GOOS: "linux", GOARCH: "amd64"}, Platform{GOOS: "windows", GOARCH: "arm64"}
+
+	res, err := Analyze(zerolint.New(), []Platform{linux, windows}, analysistest.TestData(), "./...")
+	if err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+
+	want := []struct {
+		message   string
+		platforms []Platform
+	}{
+		{`comparison of pointers to zero-size type "test/p.handle" (zl:cmp)`, []Platform{linux}},
+		{`comparison of pointers to zero-size type "test/p.marker" (zl:cmp)`, []Platform{linux, windows}},
+	}
+
+	if len(res.Findings) != len(want) {
+		t.Fatalf("Analyze() findings = %v, want %d", res.Findings, len(want))
+	}
+
+	for i, f := range res.Findings {
+		if f.Message != want[i].message || !slices.Equal(f.Platforms, want[i].platforms) {
+			t.Errorf("Analyze() finding %d = %q %v, want %q %v", i, f.Message, f.Platforms, want[i].message, want[i].platforms)
+		}
+
+		if !strings.HasSuffix(f.Posn.Filename, "p.go") {
+			t.Errorf("Analyze() finding %d in %s, want p.go", i, f.Posn.Filename)
+		}
+	}
+
+	detected, ok := res.Detected["test/p"]
+	if !ok {
+		t.Fatalf("Analyze() detected = %v, want test/p", res.Detected)
+	}
+
+	wantDetected := []string{"test/p.handle", "test/p.marker"}
+	if got := detected.Sorted(); !slices.Equal(got, wantDetected) {
+		t.Errorf("Analyze() detected = %v, want %v", got, wantDetected)
+	}
+}
@@ -28,6 +28,19 @@ func New(detected map[string]bool) Detected {
 	return Detected{detected: detected}
 }
 
+// Merge combines the zero-sized types detected in multiple analyses of a package, e.g. for different platforms.
+func Merge(ds ...Detected) Detected {
+	detected := make(map[string]bool)
+
+	for _, d := range ds {
+		for n, m := range d.detected {
+			detected[n] = detected[n] || m
+		}
+	}
+
+	return Detected{detected: detected}
+}
+
 // Empty tells whether any zero-sized types have been detected during analysis.
 func (d Detected) Empty() bool {
 	return len(d.detected) == 0
@@ -73,3 +73,22 @@ func TestResult(t *testing.T) {
 		})
 	}
 }
+
+func TestMerge(t *testing.T) {
+	t.Parallel()
+
+	d := Merge(
+		New(map[string]bool{"TypeA": false, "TypeB": false}),
+		New(map[string]bool{"TypeB": true, "TypeC": false}),
+		New(nil),
+	)
+
+	want := []string{"TypeA", "TypeB (value methods)", "TypeC"}
+	if got := d.Sorted(); !slices.Equal(got, want) {
+		t.Errorf("Merge() = %v, want %v", got, want)
+	}
+
+	if !Merge().Empty() {
+		t.Error("Expected Merge() without arguments to be empty")
+	}
+}
@@ -36,8 +36,9 @@ func (o *options) run(pass *analysis.Pass) (any, error) {
 		Check: checker.Checker{
 			Excludes: o.excludes,
 		},
-		Level:     o.level,
-		Generated: o.generated,
+		Level:        o.level,
+		Generated:    o.generated,
+		Alternatives: o.alternatives,
 	}
 	if o.regex != nil && o.regex.String() != "" {
 		v.Check.Regex = o.regex
@@ -36,7 +36,7 @@ func (*noError) Error() string {
 }
 
 var (
-	ErrOne   = &myError{}
+	ErrOne   = &myError{} // want "^variables \"ErrOne\", \"ErrTwo\" .* \\(zl:snt\\)$"
 	ErrTwo   = new(aliasError)
 	ErrThree = &noError{}
 	ErrFour  = new(noError)
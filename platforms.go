@@ -0,0 +1,234 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+
+	"fillmore-labs.com/zerolint/pkg/zerolint/platforms"
+)
+
+const platformsFlag = "platforms"
+
+// Exit codes, matching [singlechecker.Main].
+const (
+	exitOK          = 0
+	exitError       = 1
+	exitDiagnostics = 3
+)
+
+// driverFlags are the flags registered by [singlechecker.Main], mapped to whether they are boolean.
+var driverFlags = map[string]bool{
+	"flags": true, "json": true, "c": false, "fix": true, "diff": true, "source": true, "v": true, "all": true,
+	"tags": false, "debug": false, "cpuprofile": false, "memprofile": false, "trace": false, "test": true,
+}
+
+// hasFlag reports whether the flag name is given on the command line.
+func hasFlag(a *analysis.Analyzer, args []string, flagName string) bool {
+	_, ok := flagValue(a, args, flagName)
+
+	return ok
+}
+
+// boolFlag reports whether the boolean flag name is given on the command line and true.
+func boolFlag(a *analysis.Analyzer, args []string, flagName string) bool {
+	value, ok := flagValue(a, args, flagName)
+	if !ok {
+		return false
+	}
+
+	b, err := strconv.ParseBool(value)
+
+	return err == nil && b
+}
+
+// flagValue returns the last value of the flag name given on the command line.
+// The arguments are parsed like the driver does, so flag values are not mistaken for package patterns.
+func flagValue(a *analysis.Analyzer, args []string, flagName string) (string, bool) {
+	fs := flag.NewFlagSet(a.Name, flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+
+	a.Flags.VisitAll(func(f *flag.Flag) { fs.Var(placeholder{isBool: isBoolFlag(f.Value)}, f.Name, f.Usage) })
+	addDriverFlags(fs)
+
+	fs.Var(placeholder{}, platformsFlag, "")
+
+	if fs.Lookup(fixVerifyFlag) == nil {
+		fs.Var(placeholder{isBool: true}, fixVerifyFlag, "")
+	}
+
+	var value string
+	if f := fs.Lookup(flagName); f != nil {
+		p, _ := f.Value.(placeholder)
+		p.value = &value
+		f.Value = p
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return "", false // Let the driver report the error.
+	}
+
+	found := false
+	fs.Visit(func(f *flag.Flag) { found = found || f.Name == flagName })
+
+	return value, found
+}
+
+// addDriverFlags registers the driver flags not defined by the analyzer as placeholders,
+// so that they are recognized when parsing the command line.
+func addDriverFlags(fs *flag.FlagSet) {
+	for name, isBool := range driverFlags {
+		if fs.Lookup(name) == nil {
+			fs.Var(placeholder{isBool: isBool}, name, "driver flag, not supported in this mode")
+		}
+	}
+}
+
+// driverFlagsGiven returns the driver flags registered by [addDriverFlags] given on the command line, sorted by name.
+func driverFlagsGiven(fs *flag.FlagSet) []string {
+	var names []string
+
+	fs.Visit(func(f *flag.Flag) {
+		if _, ok := f.Value.(placeholder); ok {
+			names = append(names, "-"+f.Name)
+		}
+	})
+
+	return names
+}
+
+// placeholder is a [flag.Value] that only records its value when asked to, so that parsing has no side effects.
+type placeholder struct {
+	isBool bool
+	value  *string
+}
+
+func (placeholder) String() string { return "" }
+
+func (p placeholder) Set(s string) error {
+	if p.value != nil {
+		*p.value = s
+	}
+
+	return nil
+}
+
+func (p placeholder) IsBoolFlag() bool { return p.isBool }
+
+// isBoolFlag reports whether the flag value v needs no argument.
+func isBoolFlag(v flag.Value) bool {
+	b, ok := v.(interface{ IsBoolFlag() bool })
+
+	return ok && b.IsBoolFlag()
+}
+
+// runPlatforms analyzes the packages given on the command line for each of the requested platforms
+// and prints the merged findings, labeled with the platforms they apply to when not reported for all of them.
+func runPlatforms(a *analysis.Analyzer, args []string, stdout io.Writer) int {
+	fs := flag.NewFlagSet(a.Name, flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: %s -%s=GOOS/GOARCH,... [flags] [package ...]\n", a.Name, platformsFlag)
+		fs.PrintDefaults()
+	}
+
+	a.Flags.VisitAll(func(f *flag.Flag) { fs.Var(f.Value, f.Name, f.Usage) })
+
+	var list []platforms.Platform
+
+	fs.Func(platformsFlag, "analyze for each GOOS/GOARCH in this comma-separated `list`", func(s string) error {
+		var err error
+		list, err = platforms.Parse(s)
+
+		return err
+	})
+
+	addDriverFlags(fs)
+
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+
+		return exitError
+	}
+
+	names := driverFlagsGiven(fs)
+	if f := fs.Lookup(fixVerifyFlag); f != nil && f.Value.String() == "true" {
+		names = append(names, "-"+fixVerifyFlag)
+	}
+
+	if len(names) > 0 {
+		fmt.Fprintf(fs.Output(), "%s can't be combined with -%s\n", strings.Join(names, ", "), platformsFlag)
+
+		return exitError
+	}
+
+	// Trace once for all platforms instead of once per analysis.
+	zeroTrace := false
+	if f := a.Flags.Lookup("zerotrace"); f != nil {
+		zeroTrace = f.Value.String() == "true"
+		_ = f.Value.Set("false")
+	}
+
+	res, err := platforms.Analyze(a, list, "", fs.Args()...)
+	if err != nil {
+		log.Print(err)
+
+		return exitError
+	}
+
+	if zeroTrace {
+		for _, path := range slices.Sorted(maps.Keys(res.Detected)) {
+			log.Printf("Found zero-sized types in %q:\n", path)
+
+			for _, name := range res.Detected[path].Sorted() {
+				log.Printf("- %s\n", name)
+			}
+		}
+	}
+
+	for _, f := range res.Findings {
+		if len(f.Platforms) == len(list) {
+			fmt.Fprintf(stdout, "%s: %s\n", f.Posn, f.Message)
+
+			continue
+		}
+
+		labels := make([]string, len(f.Platforms))
+		for i, p := range f.Platforms {
+			labels[i] = p.String()
+		}
+
+		fmt.Fprintf(stdout, "%s: %s [%s]\n", f.Posn, f.Message, strings.Join(labels, ", "))
+	}
+
+	if len(res.Findings) > 0 {
+		return exitDiagnostics
+	}
+
+	return exitOK
+}
@@ -0,0 +1,98 @@
+// Copyright 2026 Oliver Eikemeier. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"fillmore-labs.com/zerolint/pkg/zerolint"
+)
+
+func TestHasFlag(t *testing.T) {
+	t.Parallel()
+
+	a := zerolint.New(zerolint.WithFlags(true))
+
+	tests := [...]struct {
+		name     string
+		args     string
+		flagName string
+		want     bool
+	}{
+		{"platforms", "-platforms=linux/amd64 ./...", platformsFlag, true},
+		{"platforms after value", "-level extended -platforms=linux/amd64 ./...", platformsFlag, true},
+		{"platforms after bool", "-json -c 3 -platforms linux/amd64 ./...", platformsFlag, true},
+		{"no flag", "-level extended ./...", platformsFlag, false},
+		{"after package", "./... -platforms=linux/amd64", platformsFlag, false},
+		{"after terminator", "-- -platforms=linux/amd64", platformsFlag, false},
+		{"unknown flag", "-unknown -platforms=linux/amd64", platformsFlag, false},
+		{"fix-verify after value", "-level extended -fix-verify ./...", fixVerifyFlag, true},
+		{"fix-verify after separate value", "-c 3 -platforms linux/amd64 -fix-verify ./...", fixVerifyFlag, true},
+		{"fix-verify after package", "./... -fix-verify", fixVerifyFlag, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := hasFlag(a, strings.Fields(tt.args), tt.flagName); got != tt.want {
+				t.Errorf("hasFlag(%q, %q) = %v, want %v", tt.args, tt.flagName, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunPlatformsDriverFlags(t *testing.T) {
+	t.Parallel()
+
+	a := zerolint.New(zerolint.WithFlags(true))
+
+	args := strings.Fields("-platforms=linux/amd64 -json -c 3 ./...")
+	if got := runPlatforms(a, args, io.Discard); got != exitError {
+		t.Errorf("runPlatforms(%q) = %d, want %d", args, got, exitError)
+	}
+}
+
+func TestBoolFlag(t *testing.T) {
+	t.Parallel()
+
+	a := zerolint.New(zerolint.WithFlags(true))
+
+	tests := [...]struct {
+		name string
+		args string
+		want bool
+	}{
+		{"given", "-fix-verify ./...", true},
+		{"true", "-fix-verify=true ./...", true},
+		{"false", "-fix-verify=false ./...", false},
+		{"last wins", "-fix-verify -fix-verify=false ./...", false},
+		{"not given", "-level extended ./...", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := boolFlag(a, strings.Fields(tt.args), fixVerifyFlag); got != tt.want {
+				t.Errorf("boolFlag(%q, %q) = %v, want %v", tt.args, fixVerifyFlag, got, tt.want)
+			}
+		})
+	}
+}